@@ -1,20 +1,39 @@
 package main
 
 import (
+	"1mao/config/cache"
 	"1mao/config/database"
+	"1mao/config/mailer"
 	routes "1mao/delivery/rest"
+	admin "1mao/internal/admin/domain"
+	adminRepository "1mao/internal/admin/repository"
+	adminService "1mao/internal/admin/service"
 	booking "1mao/internal/booking/domain"
+	bookingRepository "1mao/internal/booking/repository"
+	bookingService "1mao/internal/booking/service"
 	client "1mao/internal/client/domain"
 	"1mao/internal/client/repository"
 	"1mao/internal/client/service"
 	chat "1mao/internal/notification/domain"
+	notificationRepository "1mao/internal/notification/repository"
+	notificationService "1mao/internal/notification/service"
+	"1mao/internal/notification/websocket"
 	payment "1mao/internal/payment/domain"
+	paymentRepository "1mao/internal/payment/repository"
+	paymentService "1mao/internal/payment/service"
 	professional "1mao/internal/professional/domain"
+	professionalRepository "1mao/internal/professional/repository"
+	professionalService "1mao/internal/professional/service"
 
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -48,26 +67,90 @@ func main() {
 
 	models := []interface{}{
 		&client.Client{},
+		&client.SavedAddress{},
 		&professional.Professional{},
+		&professional.ServiceOffering{},
+		&professional.VerificationRequest{},
+		&professional.VerificationDocument{},
+		&admin.AdminUser{},
 		&chat.Message{},
 		&booking.Booking{},
+		&booking.BookingSeries{},
+		&booking.BookingHistory{},
+		&booking.BookingEvent{},
 		&booking.Availability{},
+		&booking.CancellationPolicy{},
+		&booking.TimeOff{},
+		&booking.CalendarToken{},
+		&booking.WaitlistEntry{},
+		&booking.Review{},
 		&payment.Transaction{},
 	}
 
 	for _, model := range models {
-		if err := db.AutoMigrate(model); err != nil{
+		if err := db.AutoMigrate(model); err != nil {
 			log.Fatalf("erro ao migrar modelo: %v", err)
 		}
 		log.Printf("tabela para %T criada com sucesso", model)
 	}
 
+	if err := bookingRepository.Migrate(db); err != nil {
+		log.Fatalf("erro ao migrar restrições de agendamento: %v", err)
+	}
+	if err := repository.Migrate(db); err != nil {
+		log.Fatalf("erro ao migrar clientes: %v", err)
+	}
+	if err := professionalRepository.Migrate(db); err != nil {
+		log.Fatalf("erro ao migrar profissionais: %v", err)
+	}
+
+	// Administrador inicial, para que alguém consiga analisar as verificações
+	if email := os.Getenv("ADMIN_EMAIL"); email != "" {
+		admins := adminService.NewAdminService(adminRepository.NewAdminRepository(db))
+		if err := admins.EnsureAdmin("Administrador", email, os.Getenv("ADMIN_PASSWORD")); err != nil {
+			log.Fatalf("erro ao criar administrador inicial: %v", err)
+		}
+	}
+
 	// Instanciar serviços
+	mail := mailer.InitMailer()
 	userRepo := repository.NewUserRepository(db)
-	clientService := service.NewClientService(userRepo)
+	clientService := service.NewClientService(userRepo, mail)
+
+	// Hub de WebSocket compartilhado entre chat e notificações
+	hub := websocket.NewHub(notificationRepository.NewMessageRepository(db))
+	go hub.Run()
+
+	// Cache dos perfis de profissionais; as avaliações também o invalidam
+	redisClient := cache.InitRedis()
+
+	payments := paymentService.NewPaymentService(paymentRepository.NewPaymentRepository(db), os.Getenv("STRIPE_KEY"), mail)
+	bookings := bookingService.NewBookingService(
+		bookingRepository.NewBookingRepository(db),
+		payments,
+		notificationService.NewNotificationService(hub),
+		mail,
+		professionalService.NewProfileCache(redisClient),
+	)
 
 	// Configuração de rotas
-	router := routes.SetupRoutes(db, &clientService)
+	router := routes.SetupRoutes(db, redisClient, &clientService, bookings, payments, hub, mail)
+
+	// Encerramento gracioso em SIGINT/SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Expiração de agendamentos pendentes não confirmados
+	expiry := bookingService.NewExpiryScheduler(
+		bookings,
+		durationFromEnv("BOOKING_PENDING_TTL", 24*time.Hour),
+		durationFromEnv("BOOKING_EXPIRY_INTERVAL", 5*time.Minute),
+	)
+	schedulerDone := make(chan struct{})
+	go func() {
+		defer close(schedulerDone)
+		expiry.Run(ctx)
+	}()
 
 	// Definir JWT_SECRET na variável de ambiente
 	token := os.Getenv("JWT_SECRET")
@@ -76,6 +159,35 @@ func main() {
 	// Obter porta da aplicação
 	server_port := os.Getenv("APP_PORT")
 
-	fmt.Printf("---- Servidor rodando na porta %s\n ----", server_port)
-	log.Fatal(http.ListenAndServe(":"+server_port, router))
+	server := &http.Server{Addr: ":" + server_port, Handler: router}
+	go func() {
+		fmt.Printf("---- Servidor rodando na porta %s\n ----", server_port)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal(err)
+		}
+	}()
+
+	<-ctx.Done()
+	log.Println("encerrando servidor...")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Printf("erro ao encerrar servidor: %v", err)
+	}
+	<-schedulerDone
+}
+
+// durationFromEnv lê uma duração (ex.: "30m", "24h") da variável de ambiente
+func durationFromEnv(key string, fallback time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		log.Printf("valor inválido para %s (%q), usando %s", key, value, fallback)
+		return fallback
+	}
+	return d
 }
@@ -0,0 +1,59 @@
+package mailer
+
+import (
+	"1mao/pkg/email"
+	"log"
+	"os"
+	"strconv"
+)
+
+// InitMailer escolhe o Mailer pelo MAIL_DRIVER:
+//   - "smtp" (padrão): SMTP_HOST, SMTP_PORT, SMTP_TLS (starttls, tls ou none),
+//     SMTP_USERNAME e SMTP_PASSWORD; usuário e senha caem para EMAIL_SERVICE
+//     e EMAIL_PASSWORD
+//   - "file": grava arquivos .eml em MAIL_DIR (padrão "mail")
+//
+// O remetente vem de MAIL_FROM, ou do usuário SMTP se vazio.
+func InitMailer() email.Mailer {
+	username := envOr("SMTP_USERNAME", os.Getenv("EMAIL_SERVICE"))
+	from := envOr("MAIL_FROM", username)
+
+	switch driver := envOr("MAIL_DRIVER", "smtp"); driver {
+	case "smtp":
+		port, err := strconv.Atoi(envOr("SMTP_PORT", "587"))
+		if err != nil {
+			panic("SMTP_PORT inválida: " + err.Error())
+		}
+		tlsMode := email.TLSMode(envOr("SMTP_TLS", string(email.TLSStartTLS)))
+		switch tlsMode {
+		case email.TLSStartTLS, email.TLSImplicit, email.TLSNone:
+		default:
+			panic("SMTP_TLS inválido: " + string(tlsMode))
+		}
+		if from == "" {
+			log.Println("⚠️ MAIL_FROM e EMAIL_SERVICE não definidos; os e-mails não serão enviados")
+		}
+		return email.NewSMTPMailer(email.SMTPConfig{
+			Host:     envOr("SMTP_HOST", "smtp.gmail.com"),
+			Port:     port,
+			TLS:      tlsMode,
+			Username: username,
+			Password: envOr("SMTP_PASSWORD", os.Getenv("EMAIL_PASSWORD")),
+			From:     from,
+		})
+	case "file":
+		if from == "" {
+			from = "1Mão <no-reply@localhost>"
+		}
+		return email.NewFileMailer(envOr("MAIL_DIR", "mail"), from)
+	default:
+		panic("MAIL_DRIVER desconhecido: " + driver)
+	}
+}
+
+func envOr(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"1mao/internal/booking/service"
+	"1mao/internal/middleware"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/gorilla/mux"
+)
+
+// ListAvailabilityHandler lista as janelas de atendimento do profissional autenticado
+// @Summary Lista horários de atendimento
+// @Description Retorna as janelas semanais de atendimento do profissional autenticado. Sem nenhuma janela cadastrada, a agenda aceita agendamentos a qualquer hora.
+// @Tags Availability
+// @Produce json
+// @Security ApiKeyAuth
+// @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"
+// @Success 200 {array} service.AvailabilityResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /professional/availability [get]
+func (h *BookingHandler) ListAvailabilityHandler(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := userIDFromClaims(r)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Token inválido")
+		return
+	}
+
+	windows, err := h.bookingService.ListAvailability(r.Context(), professionalID)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, windows)
+}
+
+// CreateAvailabilityHandler cria uma janela de atendimento
+// @Summary Cria horário de atendimento
+// @Description Adiciona uma janela semanal de atendimento para o profissional autenticado
+// @Tags Availability
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"
+// @Param availability body service.AvailabilityRequest true "Janela de atendimento"
+// @Success 201 {object} service.AvailabilityResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /professional/availability [post]
+func (h *BookingHandler) CreateAvailabilityHandler(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := userIDFromClaims(r)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Token inválido")
+		return
+	}
+
+	var req service.AvailabilityRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Formato inválido")
+		return
+	}
+
+	availability, err := h.bookingService.CreateAvailability(r.Context(), professionalID, &req)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	respondWithJSON(w, http.StatusCreated, availability)
+}
+
+// UpdateAvailabilityHandler altera uma janela de atendimento
+// @Summary Altera horário de atendimento
+// @Description Altera uma janela semanal de atendimento do profissional autenticado
+// @Tags Availability
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"
+// @Param id path int true "ID da janela"
+// @Param availability body service.AvailabilityRequest true "Janela de atendimento"
+// @Success 200 {object} service.AvailabilityResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /professional/availability/{id} [put]
+func (h *BookingHandler) UpdateAvailabilityHandler(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := userIDFromClaims(r)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Token inválido")
+		return
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "ID de horário invalido")
+		return
+	}
+
+	var req service.AvailabilityRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Formato inválido")
+		return
+	}
+
+	availability, err := h.bookingService.UpdateAvailability(r.Context(), professionalID, uint(id), &req)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, availability)
+}
+
+// DeleteAvailabilityHandler remove uma janela de atendimento
+// @Summary Remove horário de atendimento
+// @Description Remove uma janela semanal de atendimento do profissional autenticado. Remover a última janela deixa a agenda sem restrição de horário.
+// @Tags Availability
+// @Security ApiKeyAuth
+// @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"
+// @Param id path int true "ID da janela"
+// @Success 204
+// @Failure 404 {object} ErrorResponse
+// @Router /professional/availability/{id} [delete]
+func (h *BookingHandler) DeleteAvailabilityHandler(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := userIDFromClaims(r)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Token inválido")
+		return
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "ID de horário invalido")
+		return
+	}
+
+	if err := h.bookingService.DeleteAvailability(r.Context(), professionalID, uint(id)); err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListAvailableSlotsHandler lista horários livres de um profissional
+// @Summary Lista horários livres
+// @Description Retorna os intervalos livres para agendamento com um profissional entre duas datas. Profissional sem janelas de atendimento tem o dia inteiro livre.
+// @Tags Availability
+// @Produce json
+// @Param id path int true "ID do profissional"
+// @Param from query string true "Data inicial (RFC3339)"
+// @Param to query string true "Data final (RFC3339)"
+// @Param duration query int false "Duração do horário em minutos (padrão 60)"
+// @Success 200 {array} service.SlotResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /professional/{id}/slots [get]
+func (h *BookingHandler) ListAvailableSlotsHandler(w http.ResponseWriter, r *http.Request) {
+	professionalID, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "ID do profissional invalido")
+		return
+	}
+
+	query := r.URL.Query()
+	from, err := time.Parse(time.RFC3339, query.Get("from"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Parâmetro from inválido")
+		return
+	}
+	to, err := time.Parse(time.RFC3339, query.Get("to"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Parâmetro to inválido")
+		return
+	}
+
+	duration := 60
+	if durationStr := query.Get("duration"); durationStr != "" {
+		if duration, err = strconv.Atoi(durationStr); err != nil {
+			respondWithError(w, http.StatusBadRequest, "Parâmetro duration inválido")
+			return
+		}
+	}
+
+	slots, err := h.bookingService.ListAvailableSlots(r.Context(), uint(professionalID), from, to, time.Duration(duration)*time.Minute)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, slots)
+}
+
+// userIDFromClaims extrai o ID do usuário autenticado das claims do JWT
+func userIDFromClaims(r *http.Request) (uint, bool) {
+	claims, ok := r.Context().Value(middleware.UserContextKey).(jwt.MapClaims)
+	if !ok {
+		return 0, false
+	}
+	userID, ok := claims["user_id"].(float64)
+	if !ok {
+		return 0, false
+	}
+	return uint(userID), true
+}
@@ -2,11 +2,17 @@ package handlers
 
 import (
 	"1mao/internal/booking/domain"
+	"context"
 	"1mao/internal/booking/service"
 	"1mao/internal/middleware"
+	"1mao/pkg/geo"
+	"1mao/pkg/timezone"
 	"encoding/json"
+	"errors"
+	"io"
 	"log"
 	"net/http"
+	"net/url"
 	"strconv"
 	"time"
 
@@ -25,9 +31,40 @@ type ErrorResponse struct {
 // @Model UpdateStatusRequest
 type UpdateStatusRequest struct {
 	// Novo status do agendamento
-	// @Enum pending,confirmed,cancelled,completed
+	// @Enum pending,confirmed,in_progress,completed,cancelled,no_show
 	// @Example confirmed
 	Status string `json:"status"`
+	// Motivo da mudança
+	// @Example Cliente pediu para desmarcar
+	Reason string `json:"reason"`
+}
+
+// @Model CancelBookingRequest
+type CancelBookingRequest struct {
+	// Escopo do cancelamento
+	// @Enum occurrence,series
+	// @Example occurrence
+	Scope domain.CancelScope `json:"scope"`
+	// Motivo do cancelamento
+	Reason string `json:"reason"`
+}
+
+// @Model RescheduleRequest
+type RescheduleRequest struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
+
+// @Model TimeOffConflictResponse
+type TimeOffConflictResponse struct {
+	Error    string                     `json:"error"`
+	Bookings []*service.BookingResponse `json:"bookings"`
+}
+
+// @Model SeriesConflictResponse
+type SeriesConflictResponse struct {
+	Error     string                      `json:"error"`
+	Conflicts []domain.OccurrenceConflict `json:"conflicts"`
 }
 
 type BookingHandler struct {
@@ -41,7 +78,7 @@ func NewBookingHandler(bookingService service.BookingService) *BookingHandler {
 
 // CreateBookingHandler cria um novo agendamento
 // @Summary Cria um novo agendamento
-// @Description Cria um novo agendamento entre cliente e profissional
+// @Description Cria um novo agendamento entre cliente e profissional. Se o profissional limita a área atendida, o endereço do atendimento é obrigatório e precisa estar dentro dela.
 // @Tags Bookings
 // @Accept json
 // @Produce json
@@ -51,6 +88,7 @@ func NewBookingHandler(bookingService service.BookingService) *BookingHandler {
 // @Failure 400 {object} ErrorResponse
 // @Failure 401 {object} ErrorResponse
 // @Failure 409 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /bookings [post]
 func (h *BookingHandler) CreateBookingHandler(w http.ResponseWriter, r *http.Request) {
@@ -61,28 +99,14 @@ func (h *BookingHandler) CreateBookingHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	userID := uint(claims["user_id"].(float64))
-	userRole := claims["role"].(string)
-
 	var req service.CreateBookingRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		respondWithError(w, http.StatusBadRequest, "Formato inválido")
 		return
 	}
 
-	// Validação adicional baseada no perfil
-	if userRole == "professional" {
-		// Profissional só pode criar bookings para outros clientes
-		if req.ProfessionalID != userID {
-			respondWithError(w, http.StatusForbidden, "Você só pode criar agendamentos para si mesmo como profissional")
-			return
-		}
-	} else if userRole == "user" {
-		// Cliente só pode criar bookings com outros profissionais
-		if req.ClientID != userID {
-			respondWithError(w, http.StatusForbidden, "Você só pode criar agendamentos para si mesmo como cliente")
-			return
-		}
+	if !authorizeBookingRequest(w, claims, &req) {
+		return
 	}
 
 	booking, err := h.bookingService.CreateBooking(r.Context(), &req)
@@ -103,16 +127,23 @@ func (h *BookingHandler) CreateBookingHandler(w http.ResponseWriter, r *http.Req
 // @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"// @Param id path int true "ID do agendamento"
 // @Success 200 {object} service.BookingResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
 // @Router /bookings/{id} [get]
 func (h *BookingHandler) GetBookingHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value(middleware.UserContextKey).(jwt.MapClaims)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Token inválido")
+		return
+	}
+
 	vars := mux.Vars(r)
 	id, err := strconv.ParseUint(vars["id"], 10, 32)
 	if err != nil {
 		respondWithError(w, http.StatusBadRequest, "ID de agendamento invalido")
 		return
 	}
-	booking, err := h.bookingService.GetBooking(r.Context(), uint(id))
+	booking, err := h.bookingService.GetBooking(actorContext(r, claims), uint(id))
 	if err != nil {
 		handleServiceError(w, err)
 		return
@@ -129,7 +160,11 @@ func (h *BookingHandler) GetBookingHandler(w http.ResponseWriter, r *http.Reques
 // @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"// @Param from query string false "Data inicial (YYYY-MM-DD)"
 // @Param to query string false "Data final (YYYY-MM-DD)"
 // @Param status query string false "Status do agendamento"
-// @Success 200 {array} service.BookingResponse
+// @Param time_zone query string false "Fuso IANA para exibir os horários (padrão: o do profissional)"
+// @Param sort query string false "Ordenação: start_time, -start_time, created_at ou -created_at (padrão: start_time)"
+// @Param limit query int false "Tamanho da página (padrão 20, máximo 100)"
+// @Param cursor query string false "next_cursor da página anterior"
+// @Success 200 {object} service.BookingPage
 // @Failure 400 {object} ErrorResponse
 // @Failure 401 {object} ErrorResponse
 // @Router /bookings/professional [get]
@@ -171,20 +206,23 @@ func (h *BookingHandler) ListProfessionalBookingsHandler(w http.ResponseWriter,
 		filters.Status = domain.BookingStatus(statusStr)
 	}
 
-	// Chamar service
-	bookings, err := h.bookingService.ListProfessionalBookings(r.Context(), professionalID, filters)
-	if err != nil {
-		handleServiceError(w, err)
+	// Fuso de exibição
+	filters.TimeZone = query.Get("time_zone")
+
+	// Ordenação e paginação
+	if err := parsePagination(query, filters); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	// Retornar array vazio se não houver resultados
-	if bookings == nil {
-		respondWithJSON(w, http.StatusOK, []interface{}{})
+	// Chamar service
+	page, err := h.bookingService.ListProfessionalBookings(r.Context(), professionalID, filters)
+	if err != nil {
+		handleServiceError(w, err)
 		return
 	}
 
-	respondWithJSON(w, http.StatusOK, bookings)
+	respondWithJSON(w, http.StatusOK, page)
 }
 
 // ListClientBookingsHandler lista agendamentos de um cliente
@@ -198,7 +236,11 @@ func (h *BookingHandler) ListProfessionalBookingsHandler(w http.ResponseWriter,
 // @Param from query string false "Data inicial (YYYY-MM-DD)"
 // @Param to query string false "Data final (YYYY-MM-DD)"
 // @Param status query string false "Status do agendamento"
-// @Success 200 {array} service.BookingResponse
+// @Param time_zone query string false "Fuso IANA para exibir os horários (padrão: o do cliente)"
+// @Param sort query string false "Ordenação: start_time, -start_time, created_at ou -created_at (padrão: -start_time)"
+// @Param limit query int false "Tamanho da página (padrão 20, máximo 100)"
+// @Param cursor query string false "next_cursor da página anterior"
+// @Success 200 {object} service.BookingPage
 // @Failure 400 {object} ErrorResponse
 // @Router /bookings/client [get]
 func (h *BookingHandler) ListClientBookingsHandler(w http.ResponseWriter, r *http.Request) {
@@ -227,20 +269,20 @@ func (h *BookingHandler) ListClientBookingsHandler(w http.ResponseWriter, r *htt
     if statusStr := r.URL.Query().Get("status"); statusStr != "" {
         filters.Status = domain.BookingStatus(statusStr)
     }
+    filters.TimeZone = r.URL.Query().Get("time_zone")
+    if err := parsePagination(r.URL.Query(), filters); err != nil {
+        respondWithError(w, http.StatusBadRequest, err.Error())
+        return
+    }
 
     // Chamar service com filtros
-    bookings, err := h.bookingService.ListClientBookings(r.Context(), clientID, filters)
+    page, err := h.bookingService.ListClientBookings(r.Context(), clientID, filters)
     if err != nil {
         handleServiceError(w, err)
         return
     }
 
-    if bookings == nil {
-        respondWithJSON(w, http.StatusOK, []interface{}{})
-        return
-    }
-
-    respondWithJSON(w, http.StatusOK, bookings)
+    respondWithJSON(w, http.StatusOK, page)
 }
 
 // UpdateBookingStatusHandler atualiza o status de um agendamento
@@ -257,25 +299,27 @@ func (h *BookingHandler) ListClientBookingsHandler(w http.ResponseWriter, r *htt
 // @Failure 403 {object} ErrorResponse
 // @Router /bookings/{id}/status [put]
 func (h *BookingHandler) UpdateBookingStatusHandler(w http.ResponseWriter, r *http.Request) {
-	// DEBUG similar para professional_id
-	professionalIDStr := r.URL.Query().Get("professional_id")
-	log.Printf("professional_id string: %q", professionalIDStr)
+	claims, ok := r.Context().Value(middleware.UserContextKey).(jwt.MapClaims)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Token inválido")
+		return
+	}
 
-	professionalID, err := strconv.ParseUint(professionalIDStr, 10, 32)
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
 	if err != nil {
-		log.Printf("Erro na conversão do professional_id: %v", err)
-		respondWithError(w, http.StatusBadRequest, "ID do profissional deve ser um número válido")
+		respondWithError(w, http.StatusBadRequest, "ID de agendamento invalido")
 		return
 	}
-	log.Printf("professional_id convertido: %d", professionalID)
+
 	var req struct {
 		Status domain.BookingStatus `json:"status"`
+		Reason string               `json:"reason"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		respondWithError(w, http.StatusBadRequest, "Pacote de requisição invalido")
 		return
 	}
-	booking, err := h.bookingService.UpdateBookingStatus(r.Context(), uint(professionalID), req.Status)
+	booking, err := h.bookingService.UpdateBookingStatus(actorContext(r, claims), uint(id), req.Status, req.Reason)
 	if err != nil {
 		handleServiceError(w, err)
 		return
@@ -283,6 +327,214 @@ func (h *BookingHandler) UpdateBookingStatusHandler(w http.ResponseWriter, r *ht
 	respondWithJSON(w, http.StatusOK, booking)
 }
 
+// CreateRecurringBookingHandler cria uma série de agendamentos recorrentes
+// @Summary Cria agendamentos recorrentes
+// @Description Cria todas as ocorrências de uma série (semanal, quinzenal ou mensal) de forma atômica. Se alguma ocorrência conflitar, nenhuma é criada e os conflitos são listados.
+// @Tags Bookings
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"
+// @Param booking body service.CreateRecurringBookingRequest true "Dados da série"
+// @Success 201 {object} service.SeriesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} SeriesConflictResponse
+// @Router /bookings/series [post]
+func (h *BookingHandler) CreateRecurringBookingHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value(middleware.UserContextKey).(jwt.MapClaims)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Token inválido")
+		return
+	}
+
+	var req service.CreateRecurringBookingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Formato inválido")
+		return
+	}
+
+	if !authorizeBookingRequest(w, claims, &req.CreateBookingRequest) {
+		return
+	}
+
+	series, err := h.bookingService.CreateRecurringBooking(r.Context(), &req)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, series)
+}
+
+// CancelBookingHandler cancela um agendamento ou a série inteira
+// @Summary Cancela agendamento
+// @Description Cancela uma ocorrência (scope=occurrence) ou as ocorrências futuras da série (scope=series), aplicando a política de cancelamento do profissional
+// @Tags Bookings
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"
+// @Param id path int true "ID do agendamento"
+// @Param cancel body CancelBookingRequest false "Escopo do cancelamento"
+// @Success 200 {object} service.CancellationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /bookings/{id}/cancel [put]
+func (h *BookingHandler) CancelBookingHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value(middleware.UserContextKey).(jwt.MapClaims)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Token inválido")
+		return
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "ID de agendamento invalido")
+		return
+	}
+
+	// Corpo vazio (inclusive chunked, sem Content-Length) cancela só a ocorrência, sem motivo
+	req := CancelBookingRequest{Scope: domain.CancelOccurrence}
+	if r.Body != nil {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+			respondWithError(w, http.StatusBadRequest, "Formato inválido")
+			return
+		}
+	}
+	if req.Scope != domain.CancelOccurrence && req.Scope != domain.CancelSeries {
+		respondWithError(w, http.StatusBadRequest, "Escopo de cancelamento inválido")
+		return
+	}
+
+	cancellation, err := h.bookingService.CancelBooking(actorContext(r, claims), uint(id), req.Scope, req.Reason)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, cancellation)
+}
+
+// RescheduleBookingHandler remarca um agendamento
+// @Summary Remarca agendamento
+// @Description Move o agendamento para um novo horário mantendo o mesmo ID. Se end_time for omitido, a duração original é mantida.
+// @Tags Bookings
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"
+// @Param id path int true "ID do agendamento"
+// @Param schedule body RescheduleRequest true "Novo horário"
+// @Success 200 {object} service.BookingResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /bookings/{id}/schedule [put]
+func (h *BookingHandler) RescheduleBookingHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value(middleware.UserContextKey).(jwt.MapClaims)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Token inválido")
+		return
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "ID de agendamento invalido")
+		return
+	}
+
+	var req RescheduleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Formato inválido")
+		return
+	}
+
+	booking, err := h.bookingService.RescheduleBooking(actorContext(r, claims), uint(id), req.StartTime, req.EndTime)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, booking)
+}
+
+// GetBookingHistoryHandler retorna a linha do tempo de um agendamento
+// @Summary Histórico do agendamento
+// @Description Retorna as mudanças de status (com autor e motivo) e as remarcações de um agendamento
+// @Tags Bookings
+// @Produce json
+// @Security ApiKeyAuth
+// @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"
+// @Param id path int true "ID do agendamento"
+// @Success 200 {object} service.BookingHistoryResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /bookings/{id}/history [get]
+func (h *BookingHandler) GetBookingHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value(middleware.UserContextKey).(jwt.MapClaims)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Token inválido")
+		return
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "ID de agendamento invalido")
+		return
+	}
+
+	history, err := h.bookingService.GetBookingHistory(actorContext(r, claims), uint(id))
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, history)
+}
+
+// actorContext anexa o usuário autenticado ao contexto como autor da operação
+func actorContext(r *http.Request, claims jwt.MapClaims) context.Context {
+	role, _ := claims["role"].(string)
+	return domain.WithActor(r.Context(), domain.Actor{
+		ID:   uint(claims["user_id"].(float64)),
+		Role: role,
+	})
+}
+
+// authorizeBookingRequest garante que o usuário só crie agendamentos dos quais participa
+func authorizeBookingRequest(w http.ResponseWriter, claims jwt.MapClaims, req *service.CreateBookingRequest) bool {
+	userID := uint(claims["user_id"].(float64))
+	userRole := claims["role"].(string)
+
+	// Validação adicional baseada no perfil
+	if userRole == "professional" {
+		// Profissional só pode criar bookings para outros clientes
+		if req.ProfessionalID != userID {
+			respondWithError(w, http.StatusForbidden, "Você só pode criar agendamentos para si mesmo como profissional")
+			return false
+		}
+	} else if userRole == "user" {
+		// Cliente só pode criar bookings com outros profissionais
+		if req.ClientID != userID {
+			respondWithError(w, http.StatusForbidden, "Você só pode criar agendamentos para si mesmo como cliente")
+			return false
+		}
+	}
+	return true
+}
+
+// parsePagination lê sort, limit e cursor da query string
+func parsePagination(query url.Values, filters *service.BookingFilters) error {
+	filters.Sort = domain.BookingSort(query.Get("sort"))
+	filters.Cursor = query.Get("cursor")
+	if limitStr := query.Get("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			return errors.New("limit inválido")
+		}
+		filters.Limit = limit
+	}
+	return nil
+}
+
 func respondWithJSON(w http.ResponseWriter, code int, payload interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(code)
@@ -294,13 +546,107 @@ func respondWithError(w http.ResponseWriter, code int, message string) {
 }
 
 func handleServiceError(w http.ResponseWriter, err error) {
+	var conflictErr *domain.SeriesConflictError
+	if errors.As(err, &conflictErr) {
+		respondWithJSON(w, http.StatusConflict, SeriesConflictResponse{
+			Error:     "Some occurrences are unavailable",
+			Conflicts: conflictErr.Conflicts,
+		})
+		return
+	}
+
+	var timeOffErr *domain.TimeOffConflictError
+	if errors.As(err, &timeOffErr) {
+		bookings := make([]*service.BookingResponse, 0, len(timeOffErr.Bookings))
+		for _, b := range timeOffErr.Bookings {
+			bookings = append(bookings, &service.BookingResponse{
+				ID:             b.ID,
+				ProfessionalID: b.ProfessionalID,
+				ClientID:       b.ClientID,
+				StartTime:      b.StartTime,
+				EndTime:        b.EndTime,
+				Status:         b.Status,
+			})
+		}
+		respondWithJSON(w, http.StatusConflict, TimeOffConflictResponse{
+			Error:    "Time off overlaps active bookings; use force to cancel them",
+			Bookings: bookings,
+		})
+		return
+	}
+
+	if errors.Is(err, timezone.ErrInvalid) {
+		respondWithError(w, http.StatusBadRequest, "Invalid time zone")
+		return
+	}
+	if errors.Is(err, geo.ErrInvalidAddress) {
+		respondWithError(w, http.StatusBadRequest, "Invalid service address")
+		return
+	}
+
 	switch err {
 	case domain.ErrBookingNotFound:
 		respondWithError(w, http.StatusNotFound, "Booking not found")
+	case domain.ErrForbidden:
+		respondWithError(w, http.StatusForbidden, "You are not allowed to access this booking")
 	case domain.ErrTimeSlotUnavailable:
 		respondWithError(w, http.StatusConflict, "Time slot unavailable")
 	case domain.ErrInvalidStatusTransition:
 		respondWithError(w, http.StatusBadRequest, "Invalid status transition")
+	case domain.ErrOutsideWorkingHours:
+		respondWithError(w, http.StatusUnprocessableEntity, "Outside professional working hours")
+	case domain.ErrAvailabilityNotFound:
+		respondWithError(w, http.StatusNotFound, "Availability not found")
+	case domain.ErrInvalidAvailability:
+		respondWithError(w, http.StatusBadRequest, "Invalid availability window")
+	case domain.ErrInvalidSlotQuery:
+		respondWithError(w, http.StatusBadRequest, "Invalid slot query")
+	case domain.ErrServiceUnavailable:
+		respondWithError(w, http.StatusUnprocessableEntity, "Service unavailable")
+	case domain.ErrInvalidRecurrence:
+		respondWithError(w, http.StatusBadRequest, "Invalid recurrence")
+	case domain.ErrBookingNotInSeries:
+		respondWithError(w, http.StatusBadRequest, "Booking is not part of a series")
+	case domain.ErrInvalidCalendarToken:
+		respondWithError(w, http.StatusUnauthorized, "Invalid calendar token")
+	case domain.ErrTimeOffNotFound:
+		respondWithError(w, http.StatusNotFound, "Time off not found")
+	case domain.ErrInvalidTimeOff:
+		respondWithError(w, http.StatusBadRequest, "Invalid time off")
+	case domain.ErrTransitionTooEarly:
+		respondWithError(w, http.StatusUnprocessableEntity, "Status change not allowed yet")
+	case domain.ErrInvalidListQuery:
+		respondWithError(w, http.StatusBadRequest, "Invalid list query")
+	case domain.ErrInvalidCancellationPolicy:
+		respondWithError(w, http.StatusBadRequest, "Invalid cancellation policy")
+	case domain.ErrWaitlistEntryNotFound:
+		respondWithError(w, http.StatusNotFound, "Waitlist entry not found")
+	case domain.ErrInvalidWaitlistEntry:
+		respondWithError(w, http.StatusBadRequest, "Invalid waitlist entry")
+	case domain.ErrWaitlistOfferUnavailable:
+		respondWithError(w, http.StatusConflict, "Waitlist offer expired or unavailable")
+	case domain.ErrReviewNotFound:
+		respondWithError(w, http.StatusNotFound, "Review not found")
+	case domain.ErrInvalidReview:
+		respondWithError(w, http.StatusBadRequest, "Invalid review")
+	case domain.ErrReviewExists:
+		respondWithError(w, http.StatusConflict, "Booking already reviewed")
+	case domain.ErrReviewAlreadyReplied:
+		respondWithError(w, http.StatusConflict, "Review already replied")
+	case domain.ErrBookingNotReviewable:
+		respondWithError(w, http.StatusUnprocessableEntity, "Only completed bookings can be reviewed")
+	case domain.ErrBookingNotActive:
+		respondWithError(w, http.StatusConflict, "Booking is not active")
+	case domain.ErrServiceAddressRequired:
+		respondWithError(w, http.StatusBadRequest, "Service address is required for this professional")
+	case domain.ErrOutsideServiceArea:
+		respondWithError(w, http.StatusUnprocessableEntity, "Address outside the professional's service area")
+	case domain.ErrEmailNotVerified:
+		respondWithError(w, http.StatusForbidden, "Email verification required")
+	case domain.ErrStartInPast:
+		respondWithError(w, http.StatusBadRequest, "Booking cannot start in the past")
+	case domain.ErrInvalidTimeRange:
+		respondWithError(w, http.StatusBadRequest, "End time must be after start time")
 	default:
 		respondWithError(w, http.StatusInternalServerError, "Internal server error")
 	}
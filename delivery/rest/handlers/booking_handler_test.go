@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"1mao/internal/booking/domain"
+	"1mao/internal/booking/service"
+	"1mao/internal/middleware"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleServiceError(t *testing.T) {
+	tests := []struct {
+		err     error
+		code    int
+		message string
+	}{
+		{domain.ErrStartInPast, http.StatusBadRequest, "Booking cannot start in the past"},
+		{domain.ErrInvalidTimeRange, http.StatusBadRequest, "End time must be after start time"},
+		{domain.ErrBookingNotFound, http.StatusNotFound, "Booking not found"},
+		{domain.ErrTimeSlotUnavailable, http.StatusConflict, "Time slot unavailable"},
+		{fmt.Errorf("falha inesperada"), http.StatusInternalServerError, "Internal server error"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			rec := httptest.NewRecorder()
+
+			handleServiceError(rec, tt.err)
+
+			assert.Equal(t, tt.code, rec.Code)
+			var body ErrorResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+			assert.Equal(t, tt.message, body.Error)
+		})
+	}
+}
+
+// cancelRecorder implementa só o CancelBooking do serviço e guarda o pedido
+type cancelRecorder struct {
+	service.BookingService
+	scope  domain.CancelScope
+	reason string
+}
+
+func (c *cancelRecorder) CancelBooking(ctx context.Context, id uint, scope domain.CancelScope, reason string) (*service.CancellationResponse, error) {
+	c.scope, c.reason = scope, reason
+	return &service.CancellationResponse{Bookings: []*service.BookingResponse{{ID: id}}}, nil
+}
+
+func TestCancelBookingHandler_Body(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		length int64
+		code   int
+		scope  domain.CancelScope
+		reason string
+	}{
+		{"no body", "", 0, http.StatusOK, domain.CancelOccurrence, ""},
+		{"chunked without body", "", -1, http.StatusOK, domain.CancelOccurrence, ""},
+		{"chunked with body", `{"scope":"series","reason":"mudança"}`, -1, http.StatusOK, domain.CancelSeries, "mudança"},
+		{"invalid JSON", `{"scope":`, -1, http.StatusBadRequest, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &cancelRecorder{}
+			handler := NewBookingHandler(recorder)
+			req := httptest.NewRequest(http.MethodPost, "/bookings/4/cancel", io.NopCloser(strings.NewReader(tt.body)))
+			req.ContentLength = tt.length
+			req = mux.SetURLVars(req, map[string]string{"id": "4"})
+			req = req.WithContext(context.WithValue(req.Context(), middleware.UserContextKey,
+				jwt.MapClaims{"user_id": float64(2), "role": "user"}))
+			rec := httptest.NewRecorder()
+
+			handler.CancelBookingHandler(rec, req)
+
+			assert.Equal(t, tt.code, rec.Code)
+			assert.Equal(t, tt.scope, recorder.scope)
+			assert.Equal(t, tt.reason, recorder.reason)
+		})
+	}
+}
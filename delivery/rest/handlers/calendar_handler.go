@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"1mao/internal/middleware"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/gorilla/mux"
+)
+
+// CalendarTokenResponse define a resposta da geração do token do feed
+// @Model CalendarTokenResponse
+type CalendarTokenResponse struct {
+	Token string `json:"token"`
+	URL   string `json:"url" example:"/professional/1/calendar.ics?token=..."`
+}
+
+// RotateCalendarTokenHandler gera um novo link do feed iCalendar
+// @Summary Gera link do calendário
+// @Description Gera (ou substitui) o token do feed iCalendar somente leitura do profissional autenticado. O link anterior deixa de funcionar.
+// @Tags Calendar
+// @Produce json
+// @Security ApiKeyAuth
+// @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"
+// @Success 201 {object} CalendarTokenResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /professional/calendar/token [post]
+func (h *BookingHandler) RotateCalendarTokenHandler(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := userIDFromClaims(r)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Token inválido")
+		return
+	}
+
+	token, err := h.bookingService.RotateCalendarToken(r.Context(), professionalID)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	respondWithJSON(w, http.StatusCreated, CalendarTokenResponse{
+		Token: token,
+		URL:   fmt.Sprintf("/professional/%d/calendar.ics?token=%s", professionalID, token),
+	})
+}
+
+// ProfessionalCalendarHandler serve o feed iCalendar do profissional
+// @Summary Feed iCalendar do profissional
+// @Description Feed somente leitura (RFC 5545) com os agendamentos do profissional, para assinatura no Google Calendar/Outlook
+// @Tags Calendar
+// @Produce text/calendar
+// @Param id path int true "ID do profissional"
+// @Param token query string true "Token do feed"
+// @Success 200 {string} string "VCALENDAR"
+// @Failure 401 {object} ErrorResponse
+// @Router /professional/{id}/calendar.ics [get]
+func (h *BookingHandler) ProfessionalCalendarHandler(w http.ResponseWriter, r *http.Request) {
+	professionalID, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "ID do profissional invalido")
+		return
+	}
+
+	calendar, err := h.bookingService.ProfessionalCalendar(r.Context(), uint(professionalID), r.URL.Query().Get("token"))
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	respondWithCalendar(w, calendar, "")
+}
+
+// BookingCalendarHandler baixa o agendamento como arquivo .ics
+// @Summary Baixa agendamento (.ics)
+// @Description Retorna o agendamento como anexo iCalendar para importar na agenda
+// @Tags Calendar
+// @Produce text/calendar
+// @Security ApiKeyAuth
+// @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"
+// @Param id path int true "ID do agendamento"
+// @Success 200 {string} string "VCALENDAR"
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /bookings/{id}/calendar.ics [get]
+func (h *BookingHandler) BookingCalendarHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value(middleware.UserContextKey).(jwt.MapClaims)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Token inválido")
+		return
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "ID de agendamento invalido")
+		return
+	}
+
+	calendar, err := h.bookingService.BookingCalendar(actorContext(r, claims), uint(id))
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	respondWithCalendar(w, calendar, fmt.Sprintf("agendamento-%d.ics", id))
+}
+
+// respondWithCalendar escreve o iCalendar; com filename, como anexo
+func respondWithCalendar(w http.ResponseWriter, calendar []byte, filename string) {
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	if filename != "" {
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(calendar)
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"1mao/internal/booking/service"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+)
+
+// GetCancellationPolicyHandler retorna a política de cancelamento do profissional autenticado
+// @Summary Consulta política de cancelamento
+// @Description Retorna a política de cancelamento do profissional autenticado (padrão: sempre gratuito)
+// @Tags CancellationPolicy
+// @Produce json
+// @Security ApiKeyAuth
+// @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"
+// @Success 200 {object} domain.CancellationPolicy
+// @Failure 401 {object} ErrorResponse
+// @Router /professional/cancellation-policy [get]
+func (h *BookingHandler) GetCancellationPolicyHandler(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := userIDFromClaims(r)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Token inválido")
+		return
+	}
+
+	policy, err := h.bookingService.GetCancellationPolicy(r.Context(), professionalID)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, policy)
+}
+
+// UpdateCancellationPolicyHandler define a política de cancelamento
+// @Summary Define política de cancelamento
+// @Description Define até quantas horas antes o cancelamento é gratuito e as multas (% do preço) por cancelamento tardio e não comparecimento
+// @Tags CancellationPolicy
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"
+// @Param policy body service.CancellationPolicyRequest true "Política de cancelamento"
+// @Success 200 {object} domain.CancellationPolicy
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /professional/cancellation-policy [put]
+func (h *BookingHandler) UpdateCancellationPolicyHandler(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := userIDFromClaims(r)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Token inválido")
+		return
+	}
+
+	var req service.CancellationPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Formato inválido")
+		return
+	}
+
+	policy, err := h.bookingService.UpdateCancellationPolicy(r.Context(), professionalID, &req)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, policy)
+}
+
+// GetProfessionalCancellationPolicyHandler retorna a política de cancelamento de um profissional
+// @Summary Consulta política de cancelamento de um profissional
+// @Description Permite ao cliente conhecer as multas antes de agendar
+// @Tags CancellationPolicy
+// @Produce json
+// @Param id path int true "ID do profissional"
+// @Success 200 {object} domain.CancellationPolicy
+// @Failure 400 {object} ErrorResponse
+// @Router /professional/{id}/cancellation-policy [get]
+func (h *BookingHandler) GetProfessionalCancellationPolicyHandler(w http.ResponseWriter, r *http.Request) {
+	professionalID, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "ID do profissional invalido")
+		return
+	}
+
+	policy, err := h.bookingService.GetCancellationPolicy(r.Context(), uint(professionalID))
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, policy)
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"1mao/pkg/auth"
+	"errors"
+	"log"
+	"net/http"
+)
+
+// EmailVerifier confirma o e-mail de um tipo de conta (cliente ou profissional)
+type EmailVerifier interface {
+	VerifyEmail(id uint, email string) error
+}
+
+// EmailVerificationHandler atende o link de verificação enviado no cadastro.
+// O papel gravado no token escolhe qual serviço confirma a conta.
+type EmailVerificationHandler struct {
+	verifiers map[string]EmailVerifier
+}
+
+func NewEmailVerificationHandler(verifiers map[string]EmailVerifier) *EmailVerificationHandler {
+	return &EmailVerificationHandler{verifiers: verifiers}
+}
+
+// VerifyEmail godoc
+//
+//	@Summary		Verificar e-mail
+//	@Description	Confirma o e-mail de um cliente ou profissional com o token do link enviado no cadastro
+//	@Tags			Auth
+//	@Produce		json
+//	@Param			token	query		string	true	"Token de verificação"
+//	@Success		200		{object}	map[string]string
+//	@Failure		400		{object}	ErrorResponse	"Link inválido ou expirado"
+//	@Router			/verify-email [get]
+func (h *EmailVerificationHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	claims, err := auth.ParseEmailVerificationToken(r.URL.Query().Get("token"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid or expired verification link")
+		return
+	}
+	verifier, ok := h.verifiers[claims.Role]
+	if !ok {
+		respondWithError(w, http.StatusBadRequest, "Invalid or expired verification link")
+		return
+	}
+
+	if err := verifier.VerifyEmail(claims.UserID, claims.Email); err != nil {
+		if errors.Is(err, auth.ErrInvalidVerificationToken) {
+			respondWithError(w, http.StatusBadRequest, "Invalid or expired verification link")
+			return
+		}
+		log.Printf("Erro ao verificar e-mail (%s %d): %v", claims.Role, claims.UserID, err)
+		respondWithError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "Email verified"})
+}
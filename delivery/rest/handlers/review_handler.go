@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"1mao/internal/booking/service"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+)
+
+// @Model ReplyRequest
+type ReplyRequest struct {
+	// Resposta do profissional à avaliação
+	Reply string `json:"reply" example:"Obrigado pela confiança!"`
+}
+
+// CreateReviewHandler avalia um agendamento concluído
+// @Summary Avalia agendamento
+// @Description O cliente dá uma nota de 1 a 5 e um comentário a um agendamento seu já concluído. Cada agendamento aceita uma única avaliação.
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"
+// @Param id path int true "ID do agendamento"
+// @Param review body service.ReviewRequest true "Nota e comentário"
+// @Success 201 {object} domain.Review
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
+// @Router /client/bookings/{id}/review [post]
+func (h *BookingHandler) CreateReviewHandler(w http.ResponseWriter, r *http.Request) {
+	clientID, ok := userIDFromClaims(r)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Token inválido")
+		return
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "ID de agendamento invalido")
+		return
+	}
+
+	var req service.ReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Formato inválido")
+		return
+	}
+
+	review, err := h.bookingService.CreateReview(r.Context(), clientID, uint(id), &req)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	respondWithJSON(w, http.StatusCreated, review)
+}
+
+// ReplyToReviewHandler responde a uma avaliação
+// @Summary Responde avaliação
+// @Description O profissional avaliado responde uma única vez à avaliação
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"
+// @Param id path int true "ID da avaliação"
+// @Param reply body ReplyRequest true "Resposta"
+// @Success 200 {object} domain.Review
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /professional/reviews/{id}/reply [put]
+func (h *BookingHandler) ReplyToReviewHandler(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := userIDFromClaims(r)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Token inválido")
+		return
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "ID de avaliação invalido")
+		return
+	}
+
+	var req ReplyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Formato inválido")
+		return
+	}
+
+	review, err := h.bookingService.ReplyToReview(r.Context(), professionalID, uint(id), req.Reply)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, review)
+}
+
+// ListReviewsHandler lista as avaliações de um profissional
+// @Summary Lista avaliações
+// @Description Retorna as avaliações do profissional, mais recentes primeiro, paginadas por cursor
+// @Tags Reviews
+// @Produce json
+// @Param id path int true "ID do profissional"
+// @Param limit query int false "Tamanho da página (padrão 20, máximo 100)"
+// @Param cursor query string false "next_cursor da página anterior"
+// @Success 200 {object} service.ReviewPage
+// @Failure 400 {object} ErrorResponse
+// @Router /professional/{id}/reviews [get]
+func (h *BookingHandler) ListReviewsHandler(w http.ResponseWriter, r *http.Request) {
+	professionalID, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "ID do profissional invalido")
+		return
+	}
+
+	query := r.URL.Query()
+	limit := 0
+	if limitStr := query.Get("limit"); limitStr != "" {
+		if limit, err = strconv.Atoi(limitStr); err != nil || limit <= 0 {
+			respondWithError(w, http.StatusBadRequest, "limit inválido")
+			return
+		}
+	}
+
+	page, err := h.bookingService.ListReviews(r.Context(), uint(professionalID), query.Get("cursor"), limit)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, page)
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"1mao/internal/booking/service"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// ListTimeOffHandler lista as folgas do profissional autenticado
+// @Summary Lista folgas
+// @Description Retorna os períodos bloqueados do profissional autenticado, opcionalmente entre duas datas
+// @Tags TimeOff
+// @Produce json
+// @Security ApiKeyAuth
+// @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"
+// @Param from query string false "Data inicial (RFC3339)"
+// @Param to query string false "Data final (RFC3339)"
+// @Success 200 {array} domain.TimeOff
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /professional/time-off [get]
+func (h *BookingHandler) ListTimeOffHandler(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := userIDFromClaims(r)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Token inválido")
+		return
+	}
+
+	var from, to time.Time
+	var err error
+	query := r.URL.Query()
+	if value := query.Get("from"); value != "" {
+		if from, err = time.Parse(time.RFC3339, value); err != nil {
+			respondWithError(w, http.StatusBadRequest, "Parâmetro from inválido")
+			return
+		}
+	}
+	if value := query.Get("to"); value != "" {
+		if to, err = time.Parse(time.RFC3339, value); err != nil {
+			respondWithError(w, http.StatusBadRequest, "Parâmetro to inválido")
+			return
+		}
+	}
+
+	timeOff, err := h.bookingService.ListTimeOff(r.Context(), professionalID, from, to)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, timeOff)
+}
+
+// CreateTimeOffHandler bloqueia um período na agenda
+// @Summary Cria folga
+// @Description Bloqueia um período (férias, folga, doença). Se houver agendamentos ativos no período, retorna 409 com a lista; com force=true eles são cancelados e os clientes avisados.
+// @Tags TimeOff
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"
+// @Param timeOff body service.TimeOffRequest true "Período bloqueado"
+// @Success 201 {object} service.TimeOffResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 409 {object} TimeOffConflictResponse
+// @Router /professional/time-off [post]
+func (h *BookingHandler) CreateTimeOffHandler(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := userIDFromClaims(r)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Token inválido")
+		return
+	}
+
+	var req service.TimeOffRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Formato inválido")
+		return
+	}
+
+	timeOff, err := h.bookingService.CreateTimeOff(r.Context(), professionalID, &req)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	respondWithJSON(w, http.StatusCreated, timeOff)
+}
+
+// DeleteTimeOffHandler remove uma folga
+// @Summary Remove folga
+// @Description Remove um período bloqueado do profissional autenticado
+// @Tags TimeOff
+// @Security ApiKeyAuth
+// @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"
+// @Param id path int true "ID da folga"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /professional/time-off/{id} [delete]
+func (h *BookingHandler) DeleteTimeOffHandler(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := userIDFromClaims(r)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Token inválido")
+		return
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "ID de folga invalido")
+		return
+	}
+
+	if err := h.bookingService.DeleteTimeOff(r.Context(), professionalID, uint(id)); err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
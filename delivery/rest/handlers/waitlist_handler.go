@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"1mao/internal/booking/service"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+)
+
+// JoinWaitlistHandler inscreve o cliente na lista de espera de um profissional
+// @Summary Entra na lista de espera
+// @Description Inscreve o cliente autenticado para ser avisado quando um horário do profissional liberar no intervalo pedido. O horário fica reservado por um tempo limitado para quem estiver primeiro na fila.
+// @Tags Waitlist
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"
+// @Param waitlist body service.WaitlistRequest true "Profissional e intervalo desejado"
+// @Success 201 {object} domain.WaitlistEntry
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
+// @Router /client/waitlist [post]
+func (h *BookingHandler) JoinWaitlistHandler(w http.ResponseWriter, r *http.Request) {
+	clientID, ok := userIDFromClaims(r)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Token inválido")
+		return
+	}
+
+	var req service.WaitlistRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Formato inválido")
+		return
+	}
+
+	entry, err := h.bookingService.JoinWaitlist(r.Context(), clientID, &req)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	respondWithJSON(w, http.StatusCreated, entry)
+}
+
+// ListWaitlistHandler lista as inscrições do cliente
+// @Summary Lista inscrições na lista de espera
+// @Description Retorna as inscrições do cliente autenticado, incluindo ofertas em aberto
+// @Tags Waitlist
+// @Produce json
+// @Security ApiKeyAuth
+// @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"
+// @Success 200 {array} domain.WaitlistEntry
+// @Failure 401 {object} ErrorResponse
+// @Router /client/waitlist [get]
+func (h *BookingHandler) ListWaitlistHandler(w http.ResponseWriter, r *http.Request) {
+	clientID, ok := userIDFromClaims(r)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Token inválido")
+		return
+	}
+
+	entries, err := h.bookingService.ListWaitlist(r.Context(), clientID)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, entries)
+}
+
+// LeaveWaitlistHandler tira o cliente da lista de espera
+// @Summary Sai da lista de espera
+// @Description Cancela a inscrição; um horário reservado para ela é oferecido ao próximo da fila
+// @Tags Waitlist
+// @Security ApiKeyAuth
+// @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"
+// @Param id path int true "ID da inscrição"
+// @Success 204
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /client/waitlist/{id} [delete]
+func (h *BookingHandler) LeaveWaitlistHandler(w http.ResponseWriter, r *http.Request) {
+	clientID, ok := userIDFromClaims(r)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Token inválido")
+		return
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "ID de inscrição invalido")
+		return
+	}
+
+	if err := h.bookingService.LeaveWaitlist(r.Context(), clientID, uint(id)); err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AcceptWaitlistOfferHandler aceita o horário oferecido pela lista de espera
+// @Summary Aceita oferta da lista de espera
+// @Description Cria o agendamento do horário reservado para a inscrição, enquanto a reserva estiver valendo
+// @Tags Waitlist
+// @Produce json
+// @Security ApiKeyAuth
+// @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"
+// @Param id path int true "ID da inscrição"
+// @Success 201 {object} service.BookingResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /client/waitlist/{id}/accept [post]
+func (h *BookingHandler) AcceptWaitlistOfferHandler(w http.ResponseWriter, r *http.Request) {
+	clientID, ok := userIDFromClaims(r)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Token inválido")
+		return
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "ID de inscrição invalido")
+		return
+	}
+
+	booking, err := h.bookingService.AcceptWaitlistOffer(r.Context(), clientID, uint(id))
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+	respondWithJSON(w, http.StatusCreated, booking)
+}
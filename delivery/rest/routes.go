@@ -1,35 +1,27 @@
 package routes
 
 import (
+	"1mao/delivery/rest/handlers"
 	"1mao/delivery/rest/routes"
-	bookingRepository "1mao/internal/booking/repository"
 	bookingService "1mao/internal/booking/service"
 	clientService "1mao/internal/client/service"
 	"1mao/internal/middleware"
-	notificationRepository "1mao/internal/notification/repository"
+	notificationService "1mao/internal/notification/service"
 	"1mao/internal/notification/websocket"
-	"1mao/internal/payment/repository"
 	"1mao/internal/payment/service"
-	"os"
+	"1mao/pkg/auth"
+	"1mao/pkg/email"
 
 	"github.com/gorilla/mux"
+	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
 )
 
 // SetupRoutes configura todas as rotas do sistema
-func SetupRoutes(db *gorm.DB, clientService *clientService.ClientService) *mux.Router {
+func SetupRoutes(db *gorm.DB, redisClient *redis.Client, clientService *clientService.ClientService, bookingService bookingService.BookingService, paymentService service.PaymentService, hub *websocket.Hub, mailer email.Mailer) *mux.Router {
 	
 	router := mux.NewRouter()
 
-	// Criar repositório de mensagens
-	messageRepo := notificationRepository.NewMessageRepository(db)
-	bookingService := bookingService.NewBookingService(bookingRepository.NewBookingRepository(db))
-
-	paymentService := service.NewPaymentService(repository.NewPaymentRepository(db), os.Getenv("STRIPE_KEY"))
-	// Criar Hub com repositório de mensagens
-	hub := websocket.NewHub(messageRepo)
-	go hub.Run()
-
 	// Middlewares globais
 	router.Use(middleware.LoggerMiddleware)
 	router.Use(middleware.RateLimitMiddleware)
@@ -43,10 +35,17 @@ func SetupRoutes(db *gorm.DB, clientService *clientService.ClientService) *mux.R
 	routes.RegisterNotificationRoutes(router)
 	// Rota de chat
 	routes.RegisterChatRoutes(router, db, hub)
+	// Rota de administradores
+	routes.AdminRoutes(router, db)
 	// Rota de profissionais
-	routes.ProfessionalRoutes(router, db)
+	professionals := routes.ProfessionalRoutes(router, db, redisClient, notificationService.NewNotificationService(hub), mailer)
 	// Rotas de usuário (autenticação e CRUD)
 	routes.UserRoutes(router, clientService)
+	// Link de verificação de e-mail de clientes e profissionais
+	routes.EmailVerificationRoutes(router, map[string]handlers.EmailVerifier{
+		auth.RoleUser:         *clientService,
+		auth.RoleProfessional: professionals,
+	})
 	// Rotas de agendamento
 	routes.BookingRoutes(router, bookingService)
 	// Rotas de pagamento
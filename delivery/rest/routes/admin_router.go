@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"1mao/internal/admin/delivery/httpa"
+	"1mao/internal/admin/repository"
+	"1mao/internal/admin/service"
+
+	"github.com/gorilla/mux"
+	"gorm.io/gorm"
+)
+
+// AdminRoutes configura a autenticação de administradores. As rotas
+// administrativas de cada módulo ficam sob /admin com AuthMiddleware("admin").
+func AdminRoutes(router *mux.Router, db *gorm.DB) {
+	adminService := service.NewAdminService(repository.NewAdminRepository(db))
+	adminHandler := httpa.NewAdminHandler(adminService)
+
+	router.HandleFunc("/admin/login", adminHandler.Login).Methods("POST")
+}
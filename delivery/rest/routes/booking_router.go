@@ -19,14 +19,41 @@ func BookingRoutes(r *mux.Router, bookingService service.BookingService) {
     professionalRouter.HandleFunc("/bookings/all", handler.ListProfessionalBookingsHandler).Methods("GET")
     professionalRouter.HandleFunc("/bookings/{id:[0-9]+}", handler.GetBookingHandler).Methods("GET")
     professionalRouter.HandleFunc("/bookings/{id:[0-9]+}/status", handler.UpdateBookingStatusHandler).Methods("PUT")
+    professionalRouter.HandleFunc("/availability", handler.ListAvailabilityHandler).Methods("GET")
+    professionalRouter.HandleFunc("/availability", handler.CreateAvailabilityHandler).Methods("POST")
+    professionalRouter.HandleFunc("/availability/{id:[0-9]+}", handler.UpdateAvailabilityHandler).Methods("PUT")
+    professionalRouter.HandleFunc("/availability/{id:[0-9]+}", handler.DeleteAvailabilityHandler).Methods("DELETE")
+    professionalRouter.HandleFunc("/cancellation-policy", handler.GetCancellationPolicyHandler).Methods("GET")
+    professionalRouter.HandleFunc("/cancellation-policy", handler.UpdateCancellationPolicyHandler).Methods("PUT")
+    professionalRouter.HandleFunc("/time-off", handler.ListTimeOffHandler).Methods("GET")
+    professionalRouter.HandleFunc("/time-off", handler.CreateTimeOffHandler).Methods("POST")
+    professionalRouter.HandleFunc("/time-off/{id:[0-9]+}", handler.DeleteTimeOffHandler).Methods("DELETE")
+    professionalRouter.HandleFunc("/calendar/token", handler.RotateCalendarTokenHandler).Methods("POST")
+    professionalRouter.HandleFunc("/reviews/{id:[0-9]+}/reply", handler.ReplyToReviewHandler).Methods("PUT")
 
     // Rotas para clientes
     clientRouter := r.PathPrefix("/client").Subrouter()
     clientRouter.Use(middleware.AuthMiddleware("user"))
     clientRouter.HandleFunc("/bookings/all", handler.ListClientBookingsHandler).Methods("GET")
+    clientRouter.HandleFunc("/bookings/{id:[0-9]+}/review", handler.CreateReviewHandler).Methods("POST")
+    clientRouter.HandleFunc("/waitlist", handler.ListWaitlistHandler).Methods("GET")
+    clientRouter.HandleFunc("/waitlist", handler.JoinWaitlistHandler).Methods("POST")
+    clientRouter.HandleFunc("/waitlist/{id:[0-9]+}", handler.LeaveWaitlistHandler).Methods("DELETE")
+    clientRouter.HandleFunc("/waitlist/{id:[0-9]+}/accept", handler.AcceptWaitlistOfferHandler).Methods("POST")
+
+    // Rota pública de horários livres
+    r.HandleFunc("/professional/{id:[0-9]+}/slots", handler.ListAvailableSlotsHandler).Methods("GET")
+    r.HandleFunc("/professional/{id:[0-9]+}/cancellation-policy", handler.GetProfessionalCancellationPolicyHandler).Methods("GET")
+    r.HandleFunc("/professional/{id:[0-9]+}/calendar.ics", handler.ProfessionalCalendarHandler).Methods("GET")
+    r.HandleFunc("/professional/{id:[0-9]+}/reviews", handler.ListReviewsHandler).Methods("GET")
 
     // Rota compartilhada para criação
     authRouter := r.PathPrefix("").Subrouter()
     authRouter.Use(middleware.AuthMiddleware("user", "professional"))
     authRouter.HandleFunc("/bookings", handler.CreateBookingHandler).Methods("POST")
+    authRouter.HandleFunc("/bookings/series", handler.CreateRecurringBookingHandler).Methods("POST")
+    authRouter.HandleFunc("/bookings/{id:[0-9]+}/cancel", handler.CancelBookingHandler).Methods("PUT")
+    authRouter.HandleFunc("/bookings/{id:[0-9]+}/schedule", handler.RescheduleBookingHandler).Methods("PUT")
+    authRouter.HandleFunc("/bookings/{id:[0-9]+}/history", handler.GetBookingHistoryHandler).Methods("GET")
+    authRouter.HandleFunc("/bookings/{id:[0-9]+}/calendar.ics", handler.BookingCalendarHandler).Methods("GET")
 }
\ No newline at end of file
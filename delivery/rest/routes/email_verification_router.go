@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"1mao/delivery/rest/handlers"
+
+	"github.com/gorilla/mux"
+)
+
+// EmailVerificationRoutes registra o link de verificação de e-mail, comum a
+// clientes e profissionais; verifiers é indexado pelo papel da conta
+func EmailVerificationRoutes(r *mux.Router, verifiers map[string]handlers.EmailVerifier) {
+	handler := handlers.NewEmailVerificationHandler(verifiers)
+
+	r.HandleFunc("/verify-email", handler.VerifyEmail).Methods("GET")
+}
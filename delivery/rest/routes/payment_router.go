@@ -1,6 +1,7 @@
 package routes
 
 import (
+	"1mao/internal/middleware"
 	"1mao/internal/payment/delivery/httpa"
 	"1mao/internal/payment/service"
 
@@ -12,7 +13,11 @@ func PaymentRoutes(r *mux.Router, paymentService *service.PaymentService) {
 	handler := httpa.NewPaymentHandler(*paymentService)
 
 	r.HandleFunc("/payments/webhook", handler.HandleWebhook).Methods("POST")
-	r.HandleFunc("/clients/{client_id}/payments", handler.CreatePayment).Methods("POST")
 	r.HandleFunc("/payments/{id}", handler.GetPaymentStatus).Methods("GET")
-	r.HandleFunc("/clients/{client_id}/payments", handler.GetClientPayments).Methods("GET")
+
+	// Pagamentos do cliente: só o próprio cliente autenticado
+	clientRouter := r.PathPrefix("/clients").Subrouter()
+	clientRouter.Use(middleware.AuthMiddleware("user"))
+	clientRouter.HandleFunc("/{client_id:[0-9]+}/payments", handler.CreatePayment).Methods("POST")
+	clientRouter.HandleFunc("/{client_id:[0-9]+}/payments", handler.GetClientPayments).Methods("GET")
 }
@@ -1,33 +1,81 @@
 package routes
 
 import (
-	"1mao/config/cache"
 	"1mao/internal/middleware"
 	"1mao/internal/professional/delivery/httpa"
 	"1mao/internal/professional/repository"
 	"1mao/internal/professional/service"
+	"1mao/pkg/email"
+	"1mao/pkg/storage"
+	"os"
 
 	"github.com/gorilla/mux"
+	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
 )
 
-// ProfessionalRoutes configura as rotas para profissionais
-func ProfessionalRoutes(router *mux.Router, db *gorm.DB) {
+// ProfessionalRoutes configura as rotas para profissionais e devolve o serviço
+// criado para elas. Os documentos de verificação ficam em UPLOAD_DIR (padrão
+// "uploads").
+func ProfessionalRoutes(router *mux.Router, db *gorm.DB, redisClient *redis.Client, notifier service.Notifier, mailer email.Mailer) service.ProfessionalService {
 
-	redisClient := cache.InitRedis()
+	uploadDir := os.Getenv("UPLOAD_DIR")
+	if uploadDir == "" {
+		uploadDir = "uploads"
+	}
 	professionalRepo := repository.NewProfessionalRepository(db)
-	professionalService := service.NewProfessionalService(professionalRepo, redisClient)
+	professionalService := service.NewProfessionalService(professionalRepo, redisClient, storage.NewLocalStore(uploadDir), notifier, mailer)
 	professionalHandler := httpa.NewProfessionalHandler(professionalService)
 
 	// Rotas públicas
 	router.HandleFunc("/professionals", professionalHandler.GetAllProfessionals).Methods("GET")
-	router.HandleFunc("/professional/{id}", professionalHandler.GetProfessionalByID).Methods("GET")
+	router.HandleFunc("/professionals/search", professionalHandler.SearchProfessionals).Methods("GET")
+	router.HandleFunc("/professional/{id:[0-9]+}", professionalHandler.GetProfessionalByID).Methods("GET")
 	router.HandleFunc("/professional/register", professionalHandler.Register).Methods("POST")
 	router.HandleFunc("/professional/login", professionalHandler.Login).Methods("POST")
+	router.HandleFunc("/professional/forgot-password", professionalHandler.ForgotPassword).Methods("POST")
+	router.HandleFunc("/professional/reset-password", professionalHandler.ResetPassword).Methods("POST")
+	router.HandleFunc("/professional/{id:[0-9]+}/services", professionalHandler.ListServices).Methods("GET")
+	router.HandleFunc("/professional/{id:[0-9]+}/photo", professionalHandler.GetPhoto).Methods("GET")
 
 	// Rotas protegidas (somente para profissionais autenticados)
 	authRouter := router.PathPrefix("/professional").Subrouter()
 	authRouter.Use(middleware.AuthMiddleware("professional")) // Middleware agora aceita roles separadas sem precisar de slice
 	// Exemplo de rota autenticada (descomentar caso seja necessário)
 	// authRouter.HandleFunc("/dashboard", professionalHandler.Dashboard).Methods("GET")
+
+	// Perfil e conta
+	authRouter.HandleFunc("/profile", professionalHandler.UpdateProfile).Methods("PATCH")
+	authRouter.HandleFunc("/profile/photo", professionalHandler.UpdatePhoto).Methods("PUT")
+	authRouter.HandleFunc("/password", professionalHandler.ChangePassword).Methods("PUT")
+	authRouter.HandleFunc("/resend-verification", professionalHandler.ResendVerification).Methods("POST")
+	authRouter.HandleFunc("/account/deactivate", professionalHandler.DeactivateAccount).Methods("POST")
+	authRouter.HandleFunc("/account/reactivate", professionalHandler.ReactivateAccount).Methods("POST")
+	authRouter.HandleFunc("/account", professionalHandler.DeleteAccount).Methods("DELETE")
+
+	// Endereço e área de atendimento
+	authRouter.HandleFunc("/service-area", professionalHandler.UpdateServiceArea).Methods("PUT")
+
+	// Catálogo de serviços do profissional autenticado
+	authRouter.HandleFunc("/services", professionalHandler.ListMyServices).Methods("GET")
+	authRouter.HandleFunc("/services", professionalHandler.CreateService).Methods("POST")
+	authRouter.HandleFunc("/services/{service_id:[0-9]+}", professionalHandler.UpdateService).Methods("PUT")
+	authRouter.HandleFunc("/services/{service_id:[0-9]+}", professionalHandler.DeleteService).Methods("DELETE")
+
+	// Verificação do profissional
+	authRouter.HandleFunc("/verification", professionalHandler.GetVerificationStatus).Methods("GET")
+	authRouter.HandleFunc("/verification/documents", professionalHandler.UploadVerificationDocument).Methods("POST")
+	authRouter.HandleFunc("/verification/documents/{document_id:[0-9]+}", professionalHandler.DeleteVerificationDocument).Methods("DELETE")
+	authRouter.HandleFunc("/verification/submit", professionalHandler.SubmitVerification).Methods("POST")
+
+	// Análise das verificações (somente administradores)
+	adminRouter := router.PathPrefix("/admin/verifications").Subrouter()
+	adminRouter.Use(middleware.AuthMiddleware("admin"))
+	adminRouter.HandleFunc("", professionalHandler.ListVerifications).Methods("GET")
+	adminRouter.HandleFunc("/{id:[0-9]+}", professionalHandler.GetVerification).Methods("GET")
+	adminRouter.HandleFunc("/{id:[0-9]+}/approve", professionalHandler.ApproveVerification).Methods("POST")
+	adminRouter.HandleFunc("/{id:[0-9]+}/reject", professionalHandler.RejectVerification).Methods("POST")
+	adminRouter.HandleFunc("/documents/{document_id:[0-9]+}/file", professionalHandler.DownloadVerificationDocument).Methods("GET")
+
+	return professionalService
 }
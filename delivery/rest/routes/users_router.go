@@ -16,9 +16,24 @@ func UserRoutes(r *mux.Router, userService *service.ClientService) {
 	r.HandleFunc("/client/register", userHandler.Register).Methods("POST")
 	r.HandleFunc("/client/login", userHandler.Login).Methods("POST")
 	r.HandleFunc("/client/users", userHandler.GetAllUsers).Methods("GET")
+	r.HandleFunc("/client/confirm-email", userHandler.ConfirmEmailChange).Methods("POST")
+	r.HandleFunc("/client/forgot-password", userHandler.ForgotPassword).Methods("POST")
+	r.HandleFunc("/client/reset-password", userHandler.ResetPassword).Methods("POST")
 
-	// Rotas protegidas (somente para clientes autenticados)
+	// Rotas protegidas (somente para clientes autenticados; o login de
+	// cliente emite tokens com o papel "user")
 	authRouter := r.PathPrefix("/client").Subrouter()
-	authRouter.Use(middleware.AuthMiddleware("client"))
+	authRouter.Use(middleware.AuthMiddleware("user"))
 	authRouter.HandleFunc("/me", userHandler.GetProfile).Methods("GET")
+	authRouter.HandleFunc("/me", userHandler.UpdateProfile).Methods("PATCH")
+	authRouter.HandleFunc("/me", userHandler.DeleteAccount).Methods("DELETE")
+	authRouter.HandleFunc("/me/password", userHandler.ChangePassword).Methods("PUT")
+	authRouter.HandleFunc("/me/email", userHandler.RequestEmailChange).Methods("POST")
+	authRouter.HandleFunc("/me/resend-verification", userHandler.ResendVerification).Methods("POST")
+
+	// Endereços salvos
+	authRouter.HandleFunc("/me/addresses", userHandler.ListAddresses).Methods("GET")
+	authRouter.HandleFunc("/me/addresses", userHandler.CreateAddress).Methods("POST")
+	authRouter.HandleFunc("/me/addresses/{address_id:[0-9]+}", userHandler.UpdateAddress).Methods("PUT")
+	authRouter.HandleFunc("/me/addresses/{address_id:[0-9]+}", userHandler.DeleteAddress).Methods("DELETE")
 }
@@ -0,0 +1,61 @@
+package httpa
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"1mao/internal/admin/domain"
+	"1mao/internal/admin/service"
+)
+
+// LoginRequest define as credenciais do administrador
+//
+//	@Description	Credenciais para autenticação do administrador
+type LoginRequest struct {
+	Email    string `json:"email" example:"admin@example.com"`
+	Password string `json:"password" example:"senhaSegura123"`
+}
+
+// AdminHandler lida com requisições de administradores
+type AdminHandler struct {
+	service *service.AdminService
+}
+
+// NewAdminHandler cria uma nova instância do handler
+func NewAdminHandler(service *service.AdminService) *AdminHandler {
+	return &AdminHandler{service: service}
+}
+
+// Login godoc
+//
+//	@Summary		Login de administrador
+//	@Description	Autentica um administrador e retorna um token JWT com o papel admin
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		LoginRequest	true	"Credenciais de login"
+//	@Success		200		{object}	map[string]string
+//	@Failure		400		{object}	map[string]string
+//	@Failure		401		{object}	map[string]string
+//	@Router			/admin/login [post]
+func (h *AdminHandler) Login(w http.ResponseWriter, r *http.Request) {
+	var credentials LoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&credentials); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	token, err := h.service.Login(credentials.Email, credentials.Password)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidCredentials) {
+			http.Error(w, "Invalid email or password", http.StatusUnauthorized)
+			return
+		}
+		http.Error(w, "Error during login", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"token": token})
+}
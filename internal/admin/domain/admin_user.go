@@ -1,5 +1,12 @@
 package domain
 
+import "errors"
+
+// RoleAdmin é o papel gravado no JWT dos administradores
+const RoleAdmin = "admin"
+
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
 // AdminUser representa um usuário administrador do sistema
 //	@Description	Modelo de usuário administrador com controle total
 //	@name			Admin
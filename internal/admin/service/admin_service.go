@@ -1,19 +1,33 @@
 package service
 
 import (
+	"1mao/internal/admin/domain"
 	"1mao/internal/admin/repository"
 	"1mao/pkg/auth"
+	"errors"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
 )
 
+// AdminStore é o acesso aos administradores usado pelo serviço;
+// *repository.AdminRepository o implementa
+type AdminStore interface {
+	Create(admin *domain.AdminUser) error
+	FindByEmail(email string) (*domain.AdminUser, error)
+}
+
+var _ AdminStore = (*repository.AdminRepository)(nil)
+
 // 🔹 Definição correta do AdminService
 type AdminService struct {
-	repo    repository.AdminRepository // ❗ Sem o ponteiro, assumindo que é uma interface
+	repo    AdminStore
 	authSvc auth.AuthService
 }
 
 // 🔹 Adapter para o AuthService, convertendo Admin para User
 type adminServiceAdapter struct {
-	repo repository.AdminRepository
+	repo AdminStore
 }
 
 func (a *adminServiceAdapter) FindByEmail(email string) (*auth.User, error) {
@@ -29,9 +43,46 @@ func (a *adminServiceAdapter) FindByEmail(email string) (*auth.User, error) {
 }
 
 // 🔹 Função para criar o AdminService corretamente
-func NewAdminService(repo repository.AdminRepository) *AdminService {
+func NewAdminService(repo AdminStore) *AdminService {
 	authRepo := &adminServiceAdapter{repo: repo}
 	authSvc := auth.NewAuthService(authRepo, nil) // 🔹 Passando 'nil' para o ProfessionalRepository
 
 	return &AdminService{repo: repo, authSvc: authSvc}
 }
+
+// 🔹 Login de administrador; o token leva o papel "admin"
+func (s *AdminService) Login(email, password string) (string, error) {
+	admin, err := s.repo.FindByEmail(email)
+	if err != nil || !admin.IsActive {
+		return "", domain.ErrInvalidCredentials
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(admin.Password), []byte(password)); err != nil {
+		return "", domain.ErrInvalidCredentials
+	}
+	return auth.IssueToken(admin.ID, domain.RoleAdmin)
+}
+
+// 🔹 Cria o administrador inicial se ainda não existir um com o e-mail
+func (s *AdminService) EnsureAdmin(name, email, password string) error {
+	_, err := s.repo.FindByEmail(email)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if password == "" {
+		return errors.New("senha do administrador inicial não informada")
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	return s.repo.Create(&domain.AdminUser{
+		Name:     name,
+		Email:    email,
+		Password: string(hashedPassword),
+		IsActive: true,
+	})
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"testing"
+
+	"1mao/internal/admin/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+type MockAdminStore struct {
+	mock.Mock
+}
+
+func (m *MockAdminStore) Create(admin *domain.AdminUser) error {
+	args := m.Called(admin)
+	return args.Error(0)
+}
+
+func (m *MockAdminStore) FindByEmail(email string) (*domain.AdminUser, error) {
+	args := m.Called(email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.AdminUser), args.Error(1)
+}
+
+func TestEnsureAdmin(t *testing.T) {
+	t.Run("Success - creates missing admin with hashed password", func(t *testing.T) {
+		mockRepo := new(MockAdminStore)
+		adminService := NewAdminService(mockRepo)
+		mockRepo.On("FindByEmail", "admin@1mao.com").Return(nil, gorm.ErrRecordNotFound).Once()
+		mockRepo.On("Create", mock.MatchedBy(func(a *domain.AdminUser) bool {
+			return a.Email == "admin@1mao.com" && a.IsActive &&
+				bcrypt.CompareHashAndPassword([]byte(a.Password), []byte("senha123")) == nil
+		})).Return(nil).Once()
+
+		err := adminService.EnsureAdmin("Administrador", "admin@1mao.com", "senha123")
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Success - existing admin is left untouched", func(t *testing.T) {
+		mockRepo := new(MockAdminStore)
+		adminService := NewAdminService(mockRepo)
+		mockRepo.On("FindByEmail", "admin@1mao.com").Return(&domain.AdminUser{ID: 1, Email: "admin@1mao.com"}, nil).Twice()
+
+		assert.NoError(t, adminService.EnsureAdmin("Administrador", "admin@1mao.com", "senha123"))
+		assert.NoError(t, adminService.EnsureAdmin("Administrador", "admin@1mao.com", "outra-senha"))
+
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - missing password", func(t *testing.T) {
+		mockRepo := new(MockAdminStore)
+		adminService := NewAdminService(mockRepo)
+		mockRepo.On("FindByEmail", "admin@1mao.com").Return(nil, gorm.ErrRecordNotFound).Once()
+
+		err := adminService.EnsureAdmin("Administrador", "admin@1mao.com", "")
+
+		assert.Error(t, err)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("Error - lookup failure is returned", func(t *testing.T) {
+		mockRepo := new(MockAdminStore)
+		adminService := NewAdminService(mockRepo)
+		mockRepo.On("FindByEmail", "admin@1mao.com").Return(nil, assert.AnError).Once()
+
+		err := adminService.EnsureAdmin("Administrador", "admin@1mao.com", "senha123")
+
+		assert.Equal(t, assert.AnError, err)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+}
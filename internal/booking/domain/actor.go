@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// Papéis dos autores, iguais ao claim "role" do JWT
+const (
+	RoleClient       = "user"
+	RoleProfessional = "professional"
+	// Operações internas (jobs agendados)
+	RoleSystem = "system"
+)
+
+var ErrForbidden = errors.New("forbidden")
+
+// Actor identifica quem executa uma operação sobre o agendamento
+type Actor struct {
+	ID   uint
+	Role string
+}
+
+type actorContextKey struct{}
+
+// WithActor anexa o autor da operação ao contexto
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext recupera o autor da operação; retorna o valor zero se ausente
+func ActorFromContext(ctx context.Context) Actor {
+	actor, _ := ctx.Value(actorContextKey{}).(Actor)
+	return actor
+}
+
+// Participates indica se o autor é o cliente ou o profissional do
+// agendamento. O papel é comparado junto com o ID, já que clientes e
+// profissionais têm sequências de ID independentes.
+func (a Actor) Participates(b *Booking) bool {
+	switch a.Role {
+	case RoleClient:
+		return a.ID == b.ClientID
+	case RoleProfessional:
+		return a.ID == b.ProfessionalID
+	case RoleSystem:
+		return true
+	}
+	return false
+}
+
+// CanSetStatus indica se o autor pode levar o agendamento ao status next:
+// qualquer participante pode cancelar; as demais transições (confirmar,
+// iniciar, concluir, não comparecimento) são do profissional dono
+func (a Actor) CanSetStatus(b *Booking, next BookingStatus) bool {
+	if next == StatusCancelled || a.Role == RoleSystem {
+		return a.Participates(b)
+	}
+	return a.Role == RoleProfessional && a.ID == b.ProfessionalID
+}
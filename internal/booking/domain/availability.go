@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const minutesPerDay = 24 * 60
+
+var (
+	ErrAvailabilityNotFound = errors.New("availability not found")
+	ErrInvalidAvailability  = errors.New("invalid availability window")
+)
+
+// Availability representa uma janela semanal de atendimento do profissional
+//
+//	@Description	Janela de atendimento em um dia da semana, com granularidade de minutos
+//	@name			Availability
+//	@model			Availability
+type Availability struct {
+	ID             uint         `json:"id" gorm:"primaryKey"`
+	ProfessionalID uint         `json:"professional_id" gorm:"index;not null"`
+	Weekday        time.Weekday `json:"weekday" gorm:"not null"`
+	StartMinute    int          `json:"start_minute" gorm:"not null"` // 480 para as 08:00
+	EndMinute      int          `json:"end_minute" gorm:"not null"`   // 1020 para as 17:00
+	CreatedAt      time.Time    `json:"created_at"`
+	UpdatedAt      time.Time    `json:"updated_at"`
+}
+
+// Validate verifica se a janela é coerente
+func (a *Availability) Validate() error {
+	if a.Weekday < time.Sunday || a.Weekday > time.Saturday {
+		return ErrInvalidAvailability
+	}
+	if a.StartMinute < 0 || a.EndMinute > minutesPerDay || a.StartMinute >= a.EndMinute {
+		return ErrInvalidAvailability
+	}
+	return nil
+}
+
+// Overlaps indica se duas janelas do mesmo dia se sobrepõem
+func (a *Availability) Overlaps(other *Availability) bool {
+	return a.Weekday == other.Weekday &&
+		a.StartMinute < other.EndMinute &&
+		other.StartMinute < a.EndMinute
+}
+
+// Covers indica se o intervalo [start, end) cabe inteiramente na janela.
+// O horário é avaliado no fuso de start.
+func (a *Availability) Covers(start, end time.Time) bool {
+	if start.Weekday() != a.Weekday {
+		return false
+	}
+	end = end.In(start.Location())
+
+	startClock := clockOf(start)
+	var endClock time.Duration
+	switch {
+	case sameDate(start, end):
+		endClock = clockOf(end)
+	case sameDate(start.AddDate(0, 0, 1), end) && clockOf(end) == 0:
+		endClock = minutesPerDay * time.Minute
+	default:
+		return false
+	}
+
+	return startClock >= time.Duration(a.StartMinute)*time.Minute &&
+		endClock <= time.Duration(a.EndMinute)*time.Minute
+}
+
+// Unrestricted devolve janelas de dia inteiro para todos os dias da semana.
+// Profissional sem nenhuma janela cadastrada (como as contas anteriores ao
+// horário de atendimento) atende a qualquer hora até cadastrar a primeira.
+func Unrestricted(professionalID uint) []*Availability {
+	windows := make([]*Availability, 0, 7)
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		windows = append(windows, &Availability{
+			ProfessionalID: professionalID,
+			Weekday:        d,
+			StartMinute:    0,
+			EndMinute:      minutesPerDay,
+		})
+	}
+	return windows
+}
+
+// WithinAvailability indica se alguma das janelas cobre o intervalo
+func WithinAvailability(windows []*Availability, start, end time.Time) bool {
+	for _, w := range windows {
+		if w.Covers(start, end) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseClock converte "HH:MM" em minutos desde a meia-noite
+func ParseClock(value string) (int, error) {
+	var hour, minute int
+	if _, err := fmt.Sscanf(value, "%d:%d", &hour, &minute); err != nil {
+		return 0, ErrInvalidAvailability
+	}
+	if hour < 0 || minute < 0 || minute > 59 || hour*60+minute > minutesPerDay {
+		return 0, ErrInvalidAvailability
+	}
+	return hour*60 + minute, nil
+}
+
+// FormatClock converte minutos desde a meia-noite em "HH:MM"
+func FormatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+func clockOf(t time.Time) time.Duration {
+	return time.Duration(t.Hour())*time.Hour +
+		time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second +
+		time.Duration(t.Nanosecond())
+}
+
+func sameDate(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"1mao/pkg/geo"
 	"errors"
 	"time"
 )
@@ -12,13 +13,29 @@ const (
 	StatusConfirmed BookingStatus = "confirmed"
 	StatusCancelled BookingStatus = "cancelled"
 	StatusCompleted BookingStatus = "completed"
+	// Pendente que não foi confirmado dentro do prazo
+	StatusExpired BookingStatus = "expired"
+	// Profissional fez check-in e o atendimento começou
+	StatusInProgress BookingStatus = "in_progress"
+	// Cliente não compareceu
+	StatusNoShow BookingStatus = "no_show"
 )
 
+// ActiveStatuses são os status que ocupam a agenda do profissional
+var ActiveStatuses = []BookingStatus{StatusPending, StatusConfirmed, StatusInProgress}
+
 var (
 	ErrBookingNotFound         = errors.New("booking not found")
 	ErrTimeSlotUnavailable     = errors.New("time slot unavailable")
 	ErrInvalidStatusTransition = errors.New("invalid status transition")
 	ErrProfessionalUnavailable = errors.New("professional unavailable")
+	ErrOutsideWorkingHours     = errors.New("outside professional working hours")
+	ErrServiceUnavailable      = errors.New("service unavailable")
+	ErrServiceAddressRequired  = errors.New("service address required")
+	ErrOutsideServiceArea      = errors.New("address outside professional service area")
+	ErrEmailNotVerified        = errors.New("email not verified")
+	ErrStartInPast             = errors.New("booking starts in the past")
+	ErrInvalidTimeRange        = errors.New("booking must end after it starts")
 )
 
 // Booking representa um usuário cliente do sistema
@@ -35,13 +52,22 @@ type Booking struct {
 	StartTime      time.Time     `json:"start_time"`
 	EndTime        time.Time     `json:"end_time"`
 	Status         BookingStatus `json:"status"`
-	CreatedAt      time.Time     `json:"created_at"`
-	UpdatedAt      time.Time     `json:"updated_at"`
+	ServiceID      *uint         `json:"service_id,omitempty" gorm:"index"`
+	SeriesID       *uint         `json:"series_id,omitempty" gorm:"index"`
+	PriceCents     int64         `json:"price_cents"`
+	Currency       string        `json:"currency" gorm:"type:varchar(3)"`
+	// Endereço onde o serviço é prestado
+	ServiceAddress geo.Address `json:"service_address" gorm:"embedded;embeddedPrefix:service_address_"`
+	CreatedAt      time.Time   `json:"created_at"`
+	UpdatedAt      time.Time   `json:"updated_at"`
 }
 
-type Availability struct {
-	ProfessionalID uint         `json:"professional_id" gorm:"primaryKey"`
-	Weekday        time.Weekday `json:"weekday" gorm:"primaryKey"`
-	StartHour      int          `json:"start_hour"` // 8 para as 08:00
-	EndHour        int          `json:"end_hour"`   // 17 para 17:00
+// IsActive indica se o agendamento ocupa a agenda do profissional
+func (b *Booking) IsActive() bool {
+	for _, s := range ActiveStatuses {
+		if b.Status == s {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrInvalidCalendarToken = errors.New("invalid calendar token")
+
+// CalendarToken guarda o hash do token que dá acesso somente leitura ao feed
+// iCalendar do profissional. O token em si só é mostrado na geração.
+type CalendarToken struct {
+	ProfessionalID uint   `gorm:"primaryKey;autoIncrement:false"`
+	TokenHash      string `gorm:"type:char(64);not null"`
+	CreatedAt      time.Time
+}
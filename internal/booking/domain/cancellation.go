@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrInvalidCancellationPolicy = errors.New("invalid cancellation policy")
+
+// CancellationPolicy define as regras de cancelamento de um profissional
+//
+//	@Description	Política de cancelamento: gratuito até N horas antes do início, depois multa percentual
+//	@name			CancellationPolicy
+//	@model			CancellationPolicy
+type CancellationPolicy struct {
+	ProfessionalID        uint      `json:"professional_id" gorm:"primaryKey;autoIncrement:false"`
+	FreeCancellationHours int       `json:"free_cancellation_hours" example:"24"`
+	LateCancellationFee   int       `json:"late_cancellation_fee_percent" example:"50"` // % do preço
+	NoShowFee             int       `json:"no_show_fee_percent" example:"100"`          // % do preço
+	UpdatedAt             time.Time `json:"updated_at"`
+}
+
+// DefaultCancellationPolicy é usada quando o profissional não configurou
+// nenhuma política: cancelamento sempre gratuito
+func DefaultCancellationPolicy(professionalID uint) *CancellationPolicy {
+	return &CancellationPolicy{ProfessionalID: professionalID}
+}
+
+// Validate verifica se os valores da política são coerentes
+func (p *CancellationPolicy) Validate() error {
+	if p.FreeCancellationHours < 0 ||
+		p.LateCancellationFee < 0 || p.LateCancellationFee > 100 ||
+		p.NoShowFee < 0 || p.NoShowFee > 100 {
+		return ErrInvalidCancellationPolicy
+	}
+	return nil
+}
+
+// CancellationFee calcula a multa, em centavos, por cancelar o agendamento em now
+func (p *CancellationPolicy) CancellationFee(b *Booking, now time.Time) int64 {
+	deadline := b.StartTime.Add(-time.Duration(p.FreeCancellationHours) * time.Hour)
+	if now.Before(deadline) {
+		return 0
+	}
+	return b.PriceCents * int64(p.LateCancellationFee) / 100
+}
+
+// NoShowCharge calcula a multa, em centavos, quando o cliente não comparece
+func (p *CancellationPolicy) NoShowCharge(b *Booking) int64 {
+	return b.PriceCents * int64(p.NoShowFee) / 100
+}
@@ -0,0 +1,9 @@
+package domain
+
+// Contact reúne o necessário para escrever a um participante do agendamento
+type Contact struct {
+	Name     string
+	Email    string
+	Locale   string
+	TimeZone string
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrBookingNotActive = errors.New("booking is not active")
+
+// BookingHistory registra os horários anteriores de um agendamento remarcado
+//
+//	@Description	Registro de remarcação de um agendamento
+//	@name			BookingHistory
+//	@model			BookingHistory
+type BookingHistory struct {
+	ID            uint      `json:"id" gorm:"primaryKey"`
+	BookingID     uint      `json:"booking_id" gorm:"index;not null"`
+	PreviousStart time.Time `json:"previous_start" gorm:"not null"`
+	PreviousEnd   time.Time `json:"previous_end" gorm:"not null"`
+	NewStart      time.Time `json:"new_start" gorm:"not null"`
+	NewEnd        time.Time `json:"new_end" gorm:"not null"`
+	ActorID       uint      `json:"actor_id"`
+	ActorRole     string    `json:"actor_role" gorm:"type:varchar(20)"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func (BookingHistory) TableName() string {
+	return "booking_history"
+}
+
+// BookingEvent registra uma mudança de status do agendamento
+//
+//	@Description	Mudança de status de um agendamento, com autor e motivo
+//	@name			BookingEvent
+//	@model			BookingEvent
+type BookingEvent struct {
+	ID        uint          `json:"id" gorm:"primaryKey"`
+	BookingID uint          `json:"booking_id" gorm:"index;not null"`
+	ActorID   uint          `json:"actor_id"`
+	ActorRole string        `json:"actor_role" gorm:"type:varchar(20)"`
+	OldStatus BookingStatus `json:"old_status" gorm:"type:varchar(20)"`
+	NewStatus BookingStatus `json:"new_status" gorm:"type:varchar(20);not null"`
+	Reason    string        `json:"reason"`
+	CreatedAt time.Time     `json:"created_at"`
+}
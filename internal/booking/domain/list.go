@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Tamanho de página das listagens de agendamentos
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+var ErrInvalidListQuery = errors.New("invalid list query")
+
+// BookingSort define a ordenação das listagens; o prefixo "-" indica ordem decrescente
+type BookingSort string
+
+const (
+	SortStartAsc    BookingSort = "start_time"
+	SortStartDesc   BookingSort = "-start_time"
+	SortCreatedAsc  BookingSort = "created_at"
+	SortCreatedDesc BookingSort = "-created_at"
+)
+
+// Valid indica se a ordenação é suportada
+func (s BookingSort) Valid() bool {
+	switch s {
+	case SortStartAsc, SortStartDesc, SortCreatedAsc, SortCreatedDesc:
+		return true
+	}
+	return false
+}
+
+// Column devolve a coluna ordenada e se a ordem é decrescente
+func (s BookingSort) Column() (string, bool) {
+	if len(s) > 0 && s[0] == '-' {
+		return string(s[1:]), true
+	}
+	return string(s), false
+}
+
+// key devolve o valor da coluna ordenada para o agendamento
+func (s BookingSort) key(b *Booking) time.Time {
+	if column, _ := s.Column(); column == "created_at" {
+		return b.CreatedAt
+	}
+	return b.StartTime
+}
+
+// BookingCursor marca a última linha entregue de uma página (paginação por
+// chave). O ID desempata agendamentos com o mesmo valor na coluna ordenada.
+type BookingCursor struct {
+	Sort BookingSort `json:"s"`
+	Key  time.Time   `json:"k"`
+	ID   uint        `json:"i"`
+}
+
+// CursorAfter cria o cursor que continua a listagem depois de b
+func CursorAfter(sort BookingSort, b *Booking) *BookingCursor {
+	return &BookingCursor{Sort: sort, Key: sort.key(b).UTC(), ID: b.ID}
+}
+
+// Encode serializa o cursor num token opaco para a URL
+func (c *BookingCursor) Encode() string {
+	return encodeCursor(c)
+}
+
+// DecodeBookingCursor lê o token gerado por Encode
+func DecodeBookingCursor(token string) (*BookingCursor, error) {
+	var c BookingCursor
+	if err := decodeCursor(token, &c); err != nil || !c.Sort.Valid() || c.ID == 0 {
+		return nil, ErrInvalidListQuery
+	}
+	return &c, nil
+}
+
+func encodeCursor(c interface{}) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCursor(token string, c interface{}) error {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, c)
+}
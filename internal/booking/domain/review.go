@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxReviewLength limita o comentário e a resposta de uma avaliação
+const MaxReviewLength = 2000
+
+var (
+	ErrReviewNotFound       = errors.New("review not found")
+	ErrInvalidReview        = errors.New("invalid review")
+	ErrReviewExists         = errors.New("booking already reviewed")
+	ErrReviewAlreadyReplied = errors.New("review already replied")
+	ErrBookingNotReviewable = errors.New("booking is not completed")
+)
+
+// Review é a avaliação que o cliente deixa sobre um agendamento concluído.
+// Cada agendamento recebe no máximo uma avaliação e o profissional responde
+// uma única vez.
+//
+//	@Description	Avaliação de um atendimento
+//	@name			Review
+//	@model			Review
+type Review struct {
+	ID             uint       `json:"id" gorm:"primaryKey"`
+	BookingID      uint       `json:"booking_id" gorm:"uniqueIndex;not null"`
+	ProfessionalID uint       `json:"professional_id" gorm:"index:idx_reviews_professional_created,priority:1;not null"`
+	ClientID       uint       `json:"client_id" gorm:"index;not null"`
+	Rating         int        `json:"rating" gorm:"not null" example:"5"`
+	Comment        string     `json:"comment" gorm:"type:text" example:"Pontual e caprichoso"`
+	Reply          string     `json:"reply,omitempty" gorm:"type:text"`
+	RepliedAt      *time.Time `json:"replied_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at" gorm:"index:idx_reviews_professional_created,priority:2"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// Validate verifica a nota (1 a 5) e o tamanho do comentário
+func (r *Review) Validate() error {
+	if r.Rating < 1 || r.Rating > 5 {
+		return ErrInvalidReview
+	}
+	if utf8.RuneCountInString(r.Comment) > MaxReviewLength {
+		return ErrInvalidReview
+	}
+	return nil
+}
+
+// ValidateReply verifica a resposta do profissional
+func ValidateReply(reply string) error {
+	if strings.TrimSpace(reply) == "" || utf8.RuneCountInString(reply) > MaxReviewLength {
+		return ErrInvalidReview
+	}
+	return nil
+}
+
+// ReviewCursor marca a última avaliação entregue (mais recentes primeiro)
+type ReviewCursor struct {
+	CreatedAt time.Time `json:"k"`
+	ID        uint      `json:"i"`
+}
+
+// CursorAfterReview cria o cursor que continua a listagem depois de r
+func CursorAfterReview(r *Review) *ReviewCursor {
+	return &ReviewCursor{CreatedAt: r.CreatedAt.UTC(), ID: r.ID}
+}
+
+// Encode serializa o cursor num token opaco para a URL
+func (c *ReviewCursor) Encode() string {
+	return encodeCursor(c)
+}
+
+// DecodeReviewCursor lê o token gerado por Encode
+func DecodeReviewCursor(token string) (*ReviewCursor, error) {
+	var c ReviewCursor
+	if err := decodeCursor(token, &c); err != nil || c.ID == 0 {
+		return nil, ErrInvalidListQuery
+	}
+	return &c, nil
+}
@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type RecurrenceFrequency string
+
+const (
+	FrequencyWeekly   RecurrenceFrequency = "weekly"
+	FrequencyBiweekly RecurrenceFrequency = "biweekly"
+	FrequencyMonthly  RecurrenceFrequency = "monthly"
+)
+
+// MaxSeriesOccurrences limita a quantidade de ocorrências de uma série
+const MaxSeriesOccurrences = 52
+
+type CancelScope string
+
+const (
+	CancelOccurrence CancelScope = "occurrence"
+	CancelSeries     CancelScope = "series"
+)
+
+var (
+	ErrInvalidRecurrence  = errors.New("invalid recurrence")
+	ErrBookingNotInSeries = errors.New("booking is not part of a series")
+)
+
+// Recurrence descreve a regra de repetição de uma série (subconjunto do RRULE)
+type Recurrence struct {
+	Frequency RecurrenceFrequency `json:"frequency" example:"weekly"`
+	Count     int                 `json:"count,omitempty" example:"8"`
+	Until     *time.Time          `json:"until,omitempty"`
+}
+
+// BookingSeries agrupa agendamentos recorrentes entre cliente e profissional
+//
+//	@Description	Série de agendamentos recorrentes
+//	@name			BookingSeries
+//	@model			BookingSeries
+type BookingSeries struct {
+	ID             uint                `json:"id" gorm:"primaryKey"`
+	ProfessionalID uint                `json:"professional_id" gorm:"index;not null"`
+	ClientID       uint                `json:"client_id" gorm:"index;not null"`
+	ServiceID      *uint               `json:"service_id,omitempty"`
+	Frequency      RecurrenceFrequency `json:"frequency" gorm:"type:varchar(20);not null"`
+	Count          int                 `json:"count,omitempty"`
+	Until          *time.Time          `json:"until,omitempty"`
+	CreatedAt      time.Time           `json:"created_at"`
+}
+
+// Occurrences expande a regra a partir da primeira ocorrência [start, end).
+// As datas seguem o relógio local de start, então o horário se mantém em
+// mudanças de horário de verão.
+func (r *Recurrence) Occurrences(start, end time.Time) ([]Slot, error) {
+	if (r.Count > 0) == (r.Until != nil) || r.Count > MaxSeriesOccurrences {
+		return nil, ErrInvalidRecurrence
+	}
+	if r.Until != nil && r.Until.Before(start) {
+		return nil, ErrInvalidRecurrence
+	}
+
+	length := end.Sub(start)
+	var slots []Slot
+	for i := 0; ; i++ {
+		var occurrence time.Time
+		switch r.Frequency {
+		case FrequencyWeekly:
+			occurrence = start.AddDate(0, 0, 7*i)
+		case FrequencyBiweekly:
+			occurrence = start.AddDate(0, 0, 14*i)
+		case FrequencyMonthly:
+			occurrence = addMonthsClamped(start, i)
+		default:
+			return nil, ErrInvalidRecurrence
+		}
+
+		if r.Count > 0 && i >= r.Count {
+			break
+		}
+		if r.Until != nil && occurrence.After(*r.Until) {
+			break
+		}
+		if len(slots) == MaxSeriesOccurrences {
+			return nil, ErrInvalidRecurrence
+		}
+		slots = append(slots, Slot{Start: occurrence, End: occurrence.Add(length)})
+	}
+	return slots, nil
+}
+
+// addMonthsClamped soma meses mantendo o dia, limitado ao último dia do mês
+// (31/01 + 1 mês = 28/02 ou 29/02)
+func addMonthsClamped(t time.Time, months int) time.Time {
+	y, m, d := t.Date()
+	lastDay := time.Date(y, m+time.Month(months)+1, 0, 0, 0, 0, 0, t.Location()).Day()
+	if d > lastDay {
+		d = lastDay
+	}
+	return time.Date(y, m+time.Month(months), d, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// OccurrenceConflict descreve uma ocorrência da série que não pôde ser agendada
+type OccurrenceConflict struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	Reason    string    `json:"reason"`
+}
+
+// SeriesConflictError é retornado quando alguma ocorrência da série conflita.
+// Nenhuma ocorrência é criada nesse caso.
+type SeriesConflictError struct {
+	Conflicts []OccurrenceConflict
+}
+
+func (e *SeriesConflictError) Error() string {
+	return fmt.Sprintf("%d occurrence(s) unavailable", len(e.Conflicts))
+}
+
+func (e *SeriesConflictError) Unwrap() error {
+	return ErrTimeSlotUnavailable
+}
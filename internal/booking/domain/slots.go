@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// MaxSlotSearchRange limita o intervalo de busca de horários livres
+const MaxSlotSearchRange = 31 * 24 * time.Hour
+
+var ErrInvalidSlotQuery = errors.New("invalid slot query")
+
+// Slot representa um intervalo livre para agendamento
+type Slot struct {
+	Start time.Time
+	End   time.Time
+}
+
+// FreeSlots gera os intervalos de tamanho length dentro das janelas de
+// atendimento entre from e to, descartando os que colidem com agendamentos
+// ativos ou períodos de folga. Os dias são percorridos no fuso de from.
+func FreeSlots(windows []*Availability, bookings []*Booking, timeOff []*TimeOff, from, to time.Time, length time.Duration) []Slot {
+	if length <= 0 || !from.Before(to) {
+		return nil
+	}
+
+	loc := from.Location()
+	var slots []Slot
+
+	y, m, d := from.Date()
+	for day := time.Date(y, m, d, 0, 0, 0, 0, loc); day.Before(to); day = day.AddDate(0, 0, 1) {
+		for _, w := range windows {
+			if w.Weekday != day.Weekday() {
+				continue
+			}
+			windowStart := time.Date(day.Year(), day.Month(), day.Day(), 0, w.StartMinute, 0, 0, loc)
+			windowEnd := time.Date(day.Year(), day.Month(), day.Day(), 0, w.EndMinute, 0, 0, loc)
+
+			for start := windowStart; !start.Add(length).After(windowEnd); start = start.Add(length) {
+				end := start.Add(length)
+				if start.Before(from) || end.After(to) {
+					continue
+				}
+				if overlapsAny(bookings, start, end) || onTimeOff(timeOff, start, end) {
+					continue
+				}
+				slots = append(slots, Slot{Start: start, End: end})
+			}
+		}
+	}
+	return slots
+}
+
+func overlapsAny(bookings []*Booking, start, end time.Time) bool {
+	for _, b := range bookings {
+		if b.IsActive() && b.StartTime.Before(end) && start.Before(b.EndTime) {
+			return true
+		}
+	}
+	return false
+}
+
+func onTimeOff(timeOff []*TimeOff, start, end time.Time) bool {
+	for _, t := range timeOff {
+		if t.Overlaps(start, end) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// CheckInLeeway é quanto antes do início o profissional pode fazer check-in
+	CheckInLeeway = 15 * time.Minute
+	// NoShowGracePeriod é a tolerância após o início antes de marcar não comparecimento
+	NoShowGracePeriod = 15 * time.Minute
+)
+
+var ErrTransitionTooEarly = errors.New("status transition not allowed yet")
+
+// statusTransitions lista, para cada status, os próximos status permitidos
+var statusTransitions = map[BookingStatus][]BookingStatus{
+	StatusPending:    {StatusConfirmed, StatusCancelled, StatusExpired},
+	StatusConfirmed:  {StatusInProgress, StatusCompleted, StatusCancelled, StatusNoShow},
+	StatusInProgress: {StatusCompleted},
+	StatusCancelled:  {},
+	StatusCompleted:  {},
+	StatusExpired:    {},
+	StatusNoShow:     {},
+}
+
+// CanTransition indica se a máquina de estados permite ir de current para next
+func CanTransition(current, next BookingStatus) bool {
+	for _, s := range statusTransitions[current] {
+		if s == next {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateTransition verifica se o agendamento pode ir para next no instante
+// now, considerando a máquina de estados e as restrições de horário
+func (b *Booking) ValidateTransition(next BookingStatus, now time.Time) error {
+	if !CanTransition(b.Status, next) {
+		return ErrInvalidStatusTransition
+	}
+
+	switch next {
+	case StatusInProgress:
+		if now.Before(b.StartTime.Add(-CheckInLeeway)) {
+			return ErrTransitionTooEarly
+		}
+	case StatusCompleted:
+		if now.Before(b.StartTime) {
+			return ErrTransitionTooEarly
+		}
+	case StatusNoShow:
+		if now.Before(b.StartTime.Add(NoShowGracePeriod)) {
+			return ErrTransitionTooEarly
+		}
+	}
+	return nil
+}
+
+// IsReschedulable indica se o agendamento ainda pode mudar de horário
+func (b *Booking) IsReschedulable() bool {
+	return b.Status == StatusPending || b.Status == StatusConfirmed
+}
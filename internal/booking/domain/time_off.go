@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrTimeOffNotFound = errors.New("time off not found")
+	ErrInvalidTimeOff  = errors.New("invalid time off")
+	ErrTimeOffConflict = errors.New("time off overlaps active bookings")
+)
+
+// TimeOff é um período em que o profissional não atende (férias, folga, doença)
+//
+//	@Description	Período de indisponibilidade do profissional
+//	@name			TimeOff
+//	@model			TimeOff
+type TimeOff struct {
+	ID             uint      `json:"id" gorm:"primaryKey"`
+	ProfessionalID uint      `json:"professional_id" gorm:"index;not null"`
+	StartTime      time.Time `json:"start_time" gorm:"not null"`
+	EndTime        time.Time `json:"end_time" gorm:"not null"`
+	Reason         string    `json:"reason" example:"Férias"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+func (TimeOff) TableName() string {
+	return "time_offs"
+}
+
+// Validate verifica se o período é coerente
+func (t *TimeOff) Validate() error {
+	if t.StartTime.IsZero() || !t.EndTime.After(t.StartTime) {
+		return ErrInvalidTimeOff
+	}
+	return nil
+}
+
+// Overlaps indica se o período colide com o intervalo [start, end)
+func (t *TimeOff) Overlaps(start, end time.Time) bool {
+	return t.StartTime.Before(end) && start.Before(t.EndTime)
+}
+
+// TimeOffConflictError lista os agendamentos ativos que impedem o bloqueio
+type TimeOffConflictError struct {
+	Bookings []*Booking
+}
+
+func (e *TimeOffConflictError) Error() string {
+	return fmt.Sprintf("%s: %d booking(s)", ErrTimeOffConflict, len(e.Bookings))
+}
+
+func (e *TimeOffConflictError) Unwrap() error {
+	return ErrTimeOffConflict
+}
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"1mao/pkg/geo"
+	"errors"
+	"time"
+)
+
+// WaitlistHoldDuration é o tempo que o cliente tem para aceitar o horário oferecido
+const WaitlistHoldDuration = 30 * time.Minute
+
+var (
+	ErrWaitlistEntryNotFound    = errors.New("waitlist entry not found")
+	ErrInvalidWaitlistEntry     = errors.New("invalid waitlist entry")
+	ErrWaitlistOfferUnavailable = errors.New("waitlist offer unavailable")
+)
+
+type WaitlistStatus string
+
+const (
+	// Aguardando um horário liberar
+	WaitlistWaiting WaitlistStatus = "waiting"
+	// Horário oferecido e reservado até HoldExpiresAt
+	WaitlistOffered WaitlistStatus = "offered"
+	// Cliente aceitou e o agendamento foi criado
+	WaitlistBooked WaitlistStatus = "booked"
+	// Reserva venceu sem resposta
+	WaitlistExpired WaitlistStatus = "expired"
+	// Cliente saiu da lista
+	WaitlistCancelled WaitlistStatus = "cancelled"
+)
+
+// WaitlistEntry é a inscrição de um cliente para ser avisado quando um
+// horário do profissional liberar dentro de [StartTime, EndTime]
+//
+//	@Description	Inscrição na lista de espera de um profissional
+//	@name			WaitlistEntry
+//	@model			WaitlistEntry
+type WaitlistEntry struct {
+	ID             uint           `json:"id" gorm:"primaryKey"`
+	ProfessionalID uint           `json:"professional_id" gorm:"index;not null"`
+	ClientID       uint           `json:"client_id" gorm:"index;not null"`
+	StartTime      time.Time      `json:"start_time" gorm:"not null"`
+	EndTime        time.Time      `json:"end_time" gorm:"not null"`
+	Status         WaitlistStatus `json:"status" gorm:"type:varchar(16);not null;index"`
+	// Horário oferecido enquanto a reserva estiver valendo
+	OfferedStart  *time.Time `json:"offered_start,omitempty"`
+	OfferedEnd    *time.Time `json:"offered_end,omitempty"`
+	HoldExpiresAt *time.Time `json:"hold_expires_at,omitempty"`
+	// Serviço e preço do agendamento que liberou o horário oferecido
+	OfferedServiceID  *uint  `json:"offered_service_id,omitempty"`
+	OfferedPriceCents int64  `json:"offered_price_cents,omitempty"`
+	OfferedCurrency   string `json:"offered_currency,omitempty" gorm:"type:varchar(3)"`
+	BookingID         *uint  `json:"booking_id,omitempty"`
+	// Endereço do atendimento, repassado ao agendamento criado pela oferta
+	ServiceAddress geo.Address `json:"service_address" gorm:"embedded;embeddedPrefix:service_address_"`
+	CreatedAt      time.Time   `json:"created_at"`
+	UpdatedAt      time.Time   `json:"updated_at"`
+}
+
+// WaitlistOffer é um horário liberado oferecido à lista de espera, com o
+// serviço e o preço do agendamento que o liberou
+type WaitlistOffer struct {
+	ProfessionalID uint
+	Start          time.Time
+	End            time.Time
+	ServiceID      *uint
+	PriceCents     int64
+	Currency       string
+}
+
+// FreedSlot devolve a oferta do horário que o agendamento b liberou
+func FreedSlot(b *Booking) WaitlistOffer {
+	return WaitlistOffer{
+		ProfessionalID: b.ProfessionalID,
+		Start:          b.StartTime,
+		End:            b.EndTime,
+		ServiceID:      b.ServiceID,
+		PriceCents:     b.PriceCents,
+		Currency:       b.Currency,
+	}
+}
+
+// Offer devolve a oferta feita à inscrição, para repassá-la ao próximo da lista
+func (e *WaitlistEntry) Offer() WaitlistOffer {
+	return WaitlistOffer{
+		ProfessionalID: e.ProfessionalID,
+		Start:          *e.OfferedStart,
+		End:            *e.OfferedEnd,
+		ServiceID:      e.OfferedServiceID,
+		PriceCents:     e.OfferedPriceCents,
+		Currency:       e.OfferedCurrency,
+	}
+}
+
+// Validate verifica se o intervalo desejado é coerente e ainda não passou
+func (e *WaitlistEntry) Validate(now time.Time) error {
+	if e.ProfessionalID == 0 || e.StartTime.IsZero() || !e.EndTime.After(e.StartTime) {
+		return ErrInvalidWaitlistEntry
+	}
+	if !e.EndTime.After(now) || e.EndTime.Sub(e.StartTime) > MaxSlotSearchRange {
+		return ErrInvalidWaitlistEntry
+	}
+	return nil
+}
+
+// IsOpen indica se a inscrição ainda pode receber ou tem uma oferta
+func (e *WaitlistEntry) IsOpen() bool {
+	return e.Status == WaitlistWaiting || e.Status == WaitlistOffered
+}
+
+// HoldActive indica se a oferta ainda está reservada para o cliente em now
+func (e *WaitlistEntry) HoldActive(now time.Time) bool {
+	return e.Status == WaitlistOffered && e.HoldExpiresAt != nil && now.Before(*e.HoldExpiresAt)
+}
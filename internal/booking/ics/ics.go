@@ -0,0 +1,108 @@
+// Package ics gera calendários no formato iCalendar (RFC 5545)
+package ics
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	productID = "-//1Mao//Agendamentos//PT-BR"
+	// Linhas devem ser dobradas em 75 octetos (RFC 5545, 3.1)
+	maxLineOctets = 75
+	timeLayout    = "20060102T150405Z"
+)
+
+// Status de um VEVENT (RFC 5545, 3.8.1.11)
+type Status string
+
+const (
+	StatusTentative Status = "TENTATIVE"
+	StatusConfirmed Status = "CONFIRMED"
+	StatusCancelled Status = "CANCELLED"
+)
+
+// Event é um VEVENT do calendário
+type Event struct {
+	UID          string
+	Start        time.Time
+	End          time.Time
+	Summary      string
+	Description  string
+	Status       Status
+	LastModified time.Time
+}
+
+// Calendar serializa os eventos num VCALENDAR
+func Calendar(name string, events []Event) []byte {
+	var buf bytes.Buffer
+	writeLine(&buf, "BEGIN:VCALENDAR")
+	writeLine(&buf, "VERSION:2.0")
+	writeLine(&buf, "PRODID:"+productID)
+	writeLine(&buf, "CALSCALE:GREGORIAN")
+	writeLine(&buf, "METHOD:PUBLISH")
+	if name != "" {
+		writeLine(&buf, "X-WR-CALNAME:"+escapeText(name))
+	}
+
+	stamp := time.Now()
+	for _, e := range events {
+		writeLine(&buf, "BEGIN:VEVENT")
+		writeLine(&buf, "UID:"+e.UID)
+		writeLine(&buf, "DTSTAMP:"+formatTime(stamp))
+		writeLine(&buf, "DTSTART:"+formatTime(e.Start))
+		writeLine(&buf, "DTEND:"+formatTime(e.End))
+		if !e.LastModified.IsZero() {
+			writeLine(&buf, "LAST-MODIFIED:"+formatTime(e.LastModified))
+		}
+		writeLine(&buf, "SUMMARY:"+escapeText(e.Summary))
+		if e.Description != "" {
+			writeLine(&buf, "DESCRIPTION:"+escapeText(e.Description))
+		}
+		if e.Status != "" {
+			writeLine(&buf, "STATUS:"+string(e.Status))
+		}
+		writeLine(&buf, "END:VEVENT")
+	}
+
+	writeLine(&buf, "END:VCALENDAR")
+	return buf.Bytes()
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
+
+// escapeText escapa valores TEXT (RFC 5545, 3.3.11)
+func escapeText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
+
+// writeLine grava a linha terminada em CRLF, dobrando-a sem quebrar runas UTF-8
+func writeLine(buf *bytes.Buffer, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8Start(line[cut]) {
+			cut--
+		}
+		fmt.Fprintf(buf, "%s\r\n ", line[:cut])
+		line = line[cut:]
+		// Linhas de continuação começam com um espaço
+		limit = maxLineOctets - 1
+	}
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
+
+func utf8Start(b byte) bool {
+	return b&0xC0 != 0x80
+}
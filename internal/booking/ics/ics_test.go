@@ -0,0 +1,96 @@
+package ics
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscapeText(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain text", "Corte de cabelo", "Corte de cabelo"},
+		{"address with commas", "Av. Paulista, 1000, São Paulo", `Av. Paulista\, 1000\, São Paulo`},
+		{"semicolons", "bloco A; apto 12", `bloco A\; apto 12`},
+		{"backslash", `C:\agenda`, `C:\\agenda`},
+		{"LF newline", "linha 1\nlinha 2", `linha 1\nlinha 2`},
+		{"CRLF newline", "linha 1\r\nlinha 2", `linha 1\nlinha 2`},
+		{"backslash before comma is escaped once", `a\,b`, `a\\\,b`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, escapeText(tt.in))
+		})
+	}
+}
+
+func TestWriteLine(t *testing.T) {
+	tests := []struct {
+		name  string
+		line  string
+		lines int
+	}{
+		{"short line", "SUMMARY:Corte", 1},
+		{"exactly 75 octets", "SUMMARY:" + strings.Repeat("a", 67), 1},
+		{"76 octets", "SUMMARY:" + strings.Repeat("a", 68), 2},
+		{"long ASCII", "DESCRIPTION:" + strings.Repeat("abcdefghij", 20), 3},
+		{"long two-byte UTF-8", "SUMMARY:" + strings.Repeat("ção ", 40), 4},
+		{"long four-byte UTF-8", "SUMMARY:" + strings.Repeat("📅", 40), 3},
+		{"multi-byte rune straddling octet 75", "SUMMARY:" + strings.Repeat("a", 66) + "çã", 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			writeLine(&buf, tt.line)
+			out := buf.String()
+
+			require.True(t, strings.HasSuffix(out, "\r\n"))
+			physical := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+			assert.Len(t, physical, tt.lines)
+			for i, l := range physical {
+				assert.LessOrEqual(t, len(l), maxLineOctets, "linha %d", i)
+				assert.True(t, utf8.ValidString(l), "linha %d quebrou uma runa", i)
+				if i > 0 {
+					assert.True(t, strings.HasPrefix(l, " "), "continuação %d sem espaço", i)
+				}
+			}
+			// Desdobrar (RFC 5545, 3.1) devolve a linha original
+			assert.Equal(t, tt.line, strings.ReplaceAll(strings.TrimSuffix(out, "\r\n"), "\r\n ", ""))
+		})
+	}
+}
+
+func TestCalendar(t *testing.T) {
+	start := time.Date(2030, 3, 10, 13, 0, 0, 0, time.UTC)
+	out := string(Calendar("Agenda; Ana, eletricista", []Event{{
+		UID:         "booking-1@1mao",
+		Start:       start,
+		End:         start.Add(time.Hour),
+		Summary:     "Instalação elétrica completa com troca de disjuntores e revisão do quadro de força",
+		Description: "Endereço: Av. Paulista, 1000; apto 12\nSão Paulo",
+		Status:      StatusConfirmed,
+	}}))
+
+	t.Run("every line ends with CRLF", func(t *testing.T) {
+		require.True(t, strings.HasSuffix(out, "\r\n"))
+		assert.NotContains(t, strings.ReplaceAll(out, "\r\n", ""), "\n")
+		assert.NotContains(t, strings.ReplaceAll(out, "\r\n", ""), "\r")
+	})
+
+	unfolded := strings.ReplaceAll(out, "\r\n ", "")
+	assert.Contains(t, unfolded, "X-WR-CALNAME:Agenda\\; Ana\\, eletricista\r\n")
+	assert.Contains(t, unfolded, "DTSTART:20300310T130000Z\r\n")
+	assert.Contains(t, unfolded, "SUMMARY:Instalação elétrica completa com troca de disjuntores e revisão do quadro de força\r\n")
+	assert.Contains(t, unfolded, "DESCRIPTION:Endereço: Av. Paulista\\, 1000\\; apto 12\\nSão Paulo\r\n")
+	assert.Contains(t, unfolded, "STATUS:CONFIRMED\r\n")
+	assert.True(t, strings.HasPrefix(out, "BEGIN:VCALENDAR\r\n"))
+	assert.True(t, strings.HasSuffix(out, "END:VCALENDAR\r\n"))
+}
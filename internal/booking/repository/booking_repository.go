@@ -3,14 +3,15 @@ package repository
 import (
 	"1mao/internal/booking/domain"
 	professional "1mao/internal/professional/domain"
+	"1mao/pkg/geo"
 	"errors"
-	"fmt"
 	"log"
 	"time"
 
 	"context"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type BookingRepository interface {
@@ -18,16 +19,68 @@ type BookingRepository interface {
 	GetByID(ctx context.Context, id uint) (*domain.Booking, error)
 	ListByProfessional(ctx context.Context, professionalID uint, from, to time.Time) ([]*domain.Booking, error)
 	ListByClient(ctx context.Context, clientID uint, from, to time.Time) ([]*domain.Booking, error)
-	UpdateStatus(ctx context.Context, id uint, status domain.BookingStatus) (*domain.Booking, error)
+	ListPage(ctx context.Context, query *BookingListQuery) ([]*domain.Booking, error)
+	UpdateStatus(ctx context.Context, id uint, status domain.BookingStatus, reason string) (*domain.Booking, error)
+	Reschedule(ctx context.Context, id uint, start, end time.Time, actor domain.Actor) (*domain.Booking, error)
 	IsTimeSlotAvailable(ctx context.Context, professionalID uint, start, end time.Time) (bool, error)
+	GetServiceOffering(ctx context.Context, id uint) (*professional.ServiceOffering, error)
+
+	CreateSeries(ctx context.Context, series *domain.BookingSeries, occurrences []*CreateBookingRequest) ([]*domain.Booking, error)
+	CancelSeries(ctx context.Context, seriesID uint, from time.Time, reason string) ([]*domain.Booking, error)
+	ExpirePending(ctx context.Context, createdBefore time.Time) ([]*domain.Booking, error)
+
+	ListEvents(ctx context.Context, bookingID uint) ([]*domain.BookingEvent, error)
+	ListHistory(ctx context.Context, bookingID uint) ([]*domain.BookingHistory, error)
+
+	ListAvailability(ctx context.Context, professionalID uint) ([]*domain.Availability, error)
+	GetAvailability(ctx context.Context, id uint) (*domain.Availability, error)
+	CreateAvailability(ctx context.Context, availability *domain.Availability) error
+	UpdateAvailability(ctx context.Context, availability *domain.Availability) error
+	DeleteAvailability(ctx context.Context, id uint) error
+
+	GetCancellationPolicy(ctx context.Context, professionalID uint) (*domain.CancellationPolicy, error)
+	SaveCancellationPolicy(ctx context.Context, policy *domain.CancellationPolicy) error
+
+	ListTimeOff(ctx context.Context, professionalID uint, from, to time.Time) ([]*domain.TimeOff, error)
+	GetTimeOff(ctx context.Context, id uint) (*domain.TimeOff, error)
+	CreateTimeOff(ctx context.Context, timeOff *domain.TimeOff, force bool) ([]*domain.Booking, error)
+	DeleteTimeOff(ctx context.Context, id uint) error
+
+	GetCalendarToken(ctx context.Context, professionalID uint) (*domain.CalendarToken, error)
+	SaveCalendarToken(ctx context.Context, token *domain.CalendarToken) error
+	ClientNames(ctx context.Context, ids []uint) (map[uint]string, error)
+	ProfessionalNames(ctx context.Context, ids []uint) (map[uint]string, error)
+
+	ProfessionalTimeZone(ctx context.Context, professionalID uint) (string, error)
+	ClientTimeZone(ctx context.Context, clientID uint) (string, error)
+	ProfessionalServiceArea(ctx context.Context, professionalID uint) (*geo.ServiceArea, error)
+	EmailVerified(ctx context.Context, role string, id uint) (bool, error)
+	ClientContact(ctx context.Context, clientID uint) (*domain.Contact, error)
+
+	CreateWaitlistEntry(ctx context.Context, entry *domain.WaitlistEntry) error
+	GetWaitlistEntry(ctx context.Context, id uint) (*domain.WaitlistEntry, error)
+	ListWaitlistByClient(ctx context.Context, clientID uint) ([]*domain.WaitlistEntry, error)
+	CancelWaitlistEntry(ctx context.Context, id uint) (*domain.WaitlistEntry, error)
+	OfferWaitlistSlot(ctx context.Context, offer domain.WaitlistOffer, holdUntil time.Time) (*domain.WaitlistEntry, error)
+	ExpireWaitlistHolds(ctx context.Context, now time.Time) ([]*domain.WaitlistEntry, error)
+	AcceptWaitlistOffer(ctx context.Context, id uint, now time.Time) (*domain.Booking, error)
+
+	CreateReview(ctx context.Context, review *domain.Review) error
+	GetReview(ctx context.Context, id uint) (*domain.Review, error)
+	ReplyToReview(ctx context.Context, id uint, reply string) (*domain.Review, error)
+	ListReviews(ctx context.Context, professionalID uint, after *domain.ReviewCursor, limit int) ([]*domain.Review, error)
 }
 
 // CreateBookingRequest - Temporário até criar o módulo service
 type CreateBookingRequest struct {
-	ProfessionalID uint      `json:"professional_id"`
-	ClientID       uint      `json:"client_id"`
-	StartTime      time.Time `json:"start_time"`
-	EndTime        time.Time `json:"end_time"`
+	ProfessionalID uint        `json:"professional_id"`
+	ClientID       uint        `json:"client_id"`
+	StartTime      time.Time   `json:"start_time"`
+	EndTime        time.Time   `json:"end_time"`
+	ServiceID      *uint       `json:"service_id"`
+	PriceCents     int64       `json:"price_cents"`
+	Currency       string      `json:"currency"`
+	ServiceAddress geo.Address `json:"service_address"`
 }
 
 type bookingRepository struct {
@@ -38,44 +91,75 @@ func NewBookingRepository(db *gorm.DB) BookingRepository {
 	return &bookingRepository{db: db}
 }
 
-
 func (r *bookingRepository) Create(ctx context.Context, req *CreateBookingRequest) (*domain.Booking, error) {
-	// Verifica se o profissional existe
-	var professionalExists bool
-	if err := r.db.WithContext(ctx).
-		Model(&professional.Professional{}).
-		Select("count(*) > 0").
-		Where("id = ?", req.ProfessionalID).
-		Find(&professionalExists).Error; err != nil {
-		return nil, err
-	}
-	if !professionalExists {
-		return nil, domain.ErrProfessionalUnavailable
-	}
+	booking := newBooking(req)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := ensureProfessionalExists(tx, req.ProfessionalID); err != nil {
+			return err
+		}
+
+		onTimeOff, err := overlapsTimeOff(tx, req.ProfessionalID, req.StartTime, req.EndTime)
+		if err != nil {
+			return err
+		}
+		if onTimeOff {
+			return domain.ErrTimeSlotUnavailable
+		}
+
+		// Horário reservado para a lista de espera; o próprio cliente aceita
+		// a oferta por AcceptWaitlistOffer
+		held, err := overlapsHold(tx, req.ProfessionalID, req.StartTime, req.EndTime)
+		if err != nil {
+			return err
+		}
+		if held {
+			return domain.ErrTimeSlotUnavailable
+		}
 
-	// Verifica conflitos de horário
-	if available, err := r.IsTimeSlotAvailable(ctx, req.ProfessionalID, req.StartTime, req.EndTime); err != nil {
+		// A restrição bookings_no_overlap garante a exclusividade do horário
+		// mesmo com requisições concorrentes
+		if err := translateConflict(tx.Create(booking).Error); err != nil {
+			return err
+		}
+		return recordStatusEvent(ctx, tx, booking, "", "")
+	})
+	if err != nil {
 		return nil, err
-	} else if !available {
-		return nil, domain.ErrTimeSlotUnavailable
 	}
+	return booking, nil
+}
 
-	// Cria o booking
-	booking := &domain.Booking{
+func newBooking(req *CreateBookingRequest) *domain.Booking {
+	now := time.Now()
+	return &domain.Booking{
 		ProfessionalID: req.ProfessionalID,
-		ClientID:      req.ClientID,
-		StartTime:     req.StartTime,
-		EndTime:       req.EndTime,
-		Status:        domain.StatusPending,
-		CreatedAt:     time.Now(),
-		UpdatedAt:     time.Now(),
+		ClientID:       req.ClientID,
+		StartTime:      req.StartTime,
+		EndTime:        req.EndTime,
+		Status:         domain.StatusPending,
+		ServiceID:      req.ServiceID,
+		PriceCents:     req.PriceCents,
+		Currency:       req.Currency,
+		ServiceAddress: req.ServiceAddress,
+		CreatedAt:      now,
+		UpdatedAt:      now,
 	}
+}
 
-	fmt.Println("----------------")
-	fmt.Println(booking)
-	fmt.Println("----------------")
-	err := r.db.WithContext(ctx).Create(booking).Error
-	return booking, err
+// ensureProfessionalExists verifica se o profissional existe e está ativo
+func ensureProfessionalExists(tx *gorm.DB, professionalID uint) error {
+	var professionalExists bool
+	if err := tx.Model(&professional.Professional{}).
+		Select("count(*) > 0").
+		Where("id = ? AND active = ?", professionalID, true).
+		Find(&professionalExists).Error; err != nil {
+		return err
+	}
+	if !professionalExists {
+		return domain.ErrProfessionalUnavailable
+	}
+	return nil
 }
 
 func (r *bookingRepository) GetByID(ctx context.Context, id uint) (*domain.Booking, error) {
@@ -93,99 +177,239 @@ func (r *bookingRepository) GetByID(ctx context.Context, id uint) (*domain.Booki
 }
 
 func (r *bookingRepository) ListByProfessional(ctx context.Context, professionalID uint, from, to time.Time) ([]*domain.Booking, error) {
-    var bookings []*domain.Booking
-    
-    query := r.db.WithContext(ctx).
-        Where("professional_id = ?", professionalID)
+	var bookings []*domain.Booking
+
+	query := r.db.WithContext(ctx).
+		Where("professional_id = ?", professionalID)
 
-    if !from.IsZero() {
-        query = query.Where("start_time >= ?", from)
-    }
-    if !to.IsZero() {
-        query = query.Where("end_time <= ?", to)
-    }
+	if !from.IsZero() {
+		query = query.Where("start_time >= ?", from)
+	}
+	if !to.IsZero() {
+		query = query.Where("end_time <= ?", to)
+	}
 
-    err := query.Order("start_time ASC").Find(&bookings).Error
-    if err != nil {
-        return nil, err
-    }
-    return bookings, nil
+	err := query.Order("start_time ASC").Find(&bookings).Error
+	if err != nil {
+		return nil, err
+	}
+	return bookings, nil
 }
 
 func (r *bookingRepository) ListByClient(ctx context.Context, clientID uint, from, to time.Time) ([]*domain.Booking, error) {
-    log.Printf("Repository: Buscando bookings para cliente %d", clientID)
-    
-    var bookings []*domain.Booking
-    query := r.db.WithContext(ctx).Where("client_id = ?", clientID)
-
-    if !from.IsZero() {
-        query = query.Where("start_time >= ?", from)
-        log.Printf("Aplicando filtro from: %v", from)
-    }
-    if !to.IsZero() {
-        query = query.Where("end_time <= ?", to)
-        log.Printf("Aplicando filtro to: %v", to)
-    }
-
-    err := query.Order("start_time DESC").Find(&bookings).Error
-    if err != nil {
-        log.Printf("Erro ao buscar bookings: %v", err)
-        return nil, err
-    }
-
-    log.Printf("Bookings encontrados no banco: %d", len(bookings))
-    return bookings, nil
-}
-func (r *bookingRepository) UpdateStatus(ctx context.Context, id uint, status domain.BookingStatus) (*domain.Booking, error) {
+	log.Printf("Repository: Buscando bookings para cliente %d", clientID)
+
+	var bookings []*domain.Booking
+	query := r.db.WithContext(ctx).Where("client_id = ?", clientID)
+
+	if !from.IsZero() {
+		query = query.Where("start_time >= ?", from)
+		log.Printf("Aplicando filtro from: %v", from)
+	}
+	if !to.IsZero() {
+		query = query.Where("end_time <= ?", to)
+		log.Printf("Aplicando filtro to: %v", to)
+	}
+
+	err := query.Order("start_time DESC").Find(&bookings).Error
+	if err != nil {
+		log.Printf("Erro ao buscar bookings: %v", err)
+		return nil, err
+	}
+
+	log.Printf("Bookings encontrados no banco: %d", len(bookings))
+	return bookings, nil
+}
+func (r *bookingRepository) UpdateStatus(ctx context.Context, id uint, status domain.BookingStatus, reason string) (*domain.Booking, error) {
 	var booking domain.Booking
 
 	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// Lock na transação
-		if err := tx.Set("gorm:query_option", "FOR UPDATE").First(&booking, id).Error; err != nil {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&booking, id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrBookingNotFound
+			}
 			return err
 		}
 
 		// valida transição de status
-		if !isValidStatusTransition(booking.Status, status) {
-			return domain.ErrInvalidStatusTransition
+		now := time.Now()
+		if err := booking.ValidateTransition(status, now); err != nil {
+			return err
 		}
 
+		previous := booking.Status
 		booking.Status = status
-		booking.UpdatedAt = time.Now()
-		return tx.Save(&booking).Error
-
+		booking.UpdatedAt = now
+		if err := tx.Save(&booking).Error; err != nil {
+			return err
+		}
+		return recordStatusEvent(ctx, tx, &booking, previous, reason)
 	})
 	return &booking, err
 }
 
-func isValidStatusTransition(current, newStatus domain.BookingStatus) bool {
-	transitions := map[domain.BookingStatus][]domain.BookingStatus{
-		domain.StatusPending:   {domain.StatusConfirmed, domain.StatusCancelled},
-		domain.StatusConfirmed: {domain.StatusCompleted, domain.StatusCancelled},
-		domain.StatusCancelled: {},
-		domain.StatusCompleted: {},
-	}
+// ExpirePending marca como expirados os agendamentos pendentes criados antes
+// de createdBefore. Linhas bloqueadas por outra transação (ex.: uma confirmação
+// em andamento) são ignoradas e ficam para a próxima execução.
+func (r *bookingRepository) ExpirePending(ctx context.Context, createdBefore time.Time) ([]*domain.Booking, error) {
+	var bookings []*domain.Booking
 
-	allowed, exists := transitions[current]
-	if !exists {
-		return false
-	}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ?", domain.StatusPending).
+			Where("created_at < ?", createdBefore).
+			Order("created_at ASC").
+			Find(&bookings).Error; err != nil {
+			return err
+		}
 
-	for _, s := range allowed {
-		if s == newStatus {
-			return true
+		now := time.Now()
+		for _, booking := range bookings {
+			booking.Status = domain.StatusExpired
+			booking.UpdatedAt = now
+			if err := tx.Save(booking).Error; err != nil {
+				return err
+			}
+			if err := recordStatusEvent(ctx, tx, booking, domain.StatusPending, "não confirmado dentro do prazo"); err != nil {
+				return err
+			}
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	return false
+	return bookings, nil
 }
 
 func (r *bookingRepository) IsTimeSlotAvailable(ctx context.Context, professionalID uint, start, end time.Time) (bool, error) {
+	return isTimeSlotAvailable(r.db.WithContext(ctx), professionalID, start, end)
+}
+
+func isTimeSlotAvailable(db *gorm.DB, professionalID uint, start, end time.Time, excludeIDs ...uint) (bool, error) {
 	var count int64
 
-	err := r.db.WithContext(ctx).Model(&domain.Booking{}).Where("professional_id = ?", professionalID).Where("status IN ?", []domain.BookingStatus{
-		domain.StatusPending,
-		domain.StatusConfirmed,
-	}).
-		Where("(start_time, end_time) OVERLAPS (?, ?)", start, end).Count(&count).Error
-	return count == 0, err
+	query := db.Model(&domain.Booking{}).Where("professional_id = ?", professionalID).Where("status IN ?", domain.ActiveStatuses).
+		Where("(start_time, end_time) OVERLAPS (?, ?)", start, end)
+	if len(excludeIDs) > 0 {
+		query = query.Where("id NOT IN ?", excludeIDs)
+	}
+	if err := query.Count(&count).Error; err != nil {
+		return false, err
+	}
+	if count > 0 {
+		return false, nil
+	}
+
+	// Folgas do profissional e reservas da lista de espera também bloqueiam o horário
+	onTimeOff, err := overlapsTimeOff(db, professionalID, start, end)
+	if err != nil || onTimeOff {
+		return false, err
+	}
+	held, err := overlapsHold(db, professionalID, start, end)
+	return !held, err
+}
+
+// Reschedule move o agendamento para o novo horário mantendo o mesmo ID e
+// registra os horários anteriores em booking_history
+func (r *bookingRepository) Reschedule(ctx context.Context, id uint, start, end time.Time, actor domain.Actor) (*domain.Booking, error) {
+	var booking domain.Booking
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&booking, id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrBookingNotFound
+			}
+			return err
+		}
+		if !booking.IsReschedulable() {
+			return domain.ErrBookingNotActive
+		}
+
+		available, err := isTimeSlotAvailable(tx, booking.ProfessionalID, start, end, booking.ID)
+		if err != nil {
+			return err
+		}
+		if !available {
+			return domain.ErrTimeSlotUnavailable
+		}
+
+		history := &domain.BookingHistory{
+			BookingID:     booking.ID,
+			PreviousStart: booking.StartTime,
+			PreviousEnd:   booking.EndTime,
+			NewStart:      start,
+			NewEnd:        end,
+			ActorID:       actor.ID,
+			ActorRole:     actor.Role,
+		}
+		if err := tx.Create(history).Error; err != nil {
+			return err
+		}
+
+		booking.StartTime = start
+		booking.EndTime = end
+		booking.UpdatedAt = time.Now()
+		return translateConflict(tx.Save(&booking).Error)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &booking, nil
+}
+
+func (r *bookingRepository) GetServiceOffering(ctx context.Context, id uint) (*professional.ServiceOffering, error) {
+	var offering professional.ServiceOffering
+	err := r.db.WithContext(ctx).First(&offering, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrServiceUnavailable
+		}
+		return nil, err
+	}
+	return &offering, nil
+}
+
+func (r *bookingRepository) ListAvailability(ctx context.Context, professionalID uint) ([]*domain.Availability, error) {
+	var windows []*domain.Availability
+	err := r.db.WithContext(ctx).
+		Where("professional_id = ?", professionalID).
+		Order("weekday ASC, start_minute ASC").
+		Find(&windows).Error
+	if err != nil {
+		return nil, err
+	}
+	return windows, nil
+}
+
+func (r *bookingRepository) GetAvailability(ctx context.Context, id uint) (*domain.Availability, error) {
+	var availability domain.Availability
+	err := r.db.WithContext(ctx).First(&availability, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrAvailabilityNotFound
+		}
+		return nil, err
+	}
+	return &availability, nil
+}
+
+func (r *bookingRepository) CreateAvailability(ctx context.Context, availability *domain.Availability) error {
+	return r.db.WithContext(ctx).Create(availability).Error
+}
+
+func (r *bookingRepository) UpdateAvailability(ctx context.Context, availability *domain.Availability) error {
+	return r.db.WithContext(ctx).Save(availability).Error
+}
+
+func (r *bookingRepository) DeleteAvailability(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&domain.Availability{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrAvailabilityNotFound
+	}
+	return nil
 }
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"1mao/internal/booking/domain"
+	client "1mao/internal/client/domain"
+	professional "1mao/internal/professional/domain"
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+func (r *bookingRepository) GetCalendarToken(ctx context.Context, professionalID uint) (*domain.CalendarToken, error) {
+	var token domain.CalendarToken
+	err := r.db.WithContext(ctx).First(&token, "professional_id = ?", professionalID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrInvalidCalendarToken
+		}
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *bookingRepository) SaveCalendarToken(ctx context.Context, token *domain.CalendarToken) error {
+	return r.db.WithContext(ctx).Save(token).Error
+}
+
+// ClientNames devolve o nome de cada cliente, indexado pelo ID. Contas
+// excluídas entram com o nome anonimizado.
+func (r *bookingRepository) ClientNames(ctx context.Context, ids []uint) (map[uint]string, error) {
+	var clients []client.Client
+	names := make(map[uint]string, len(ids))
+	if len(ids) == 0 {
+		return names, nil
+	}
+	if err := r.db.WithContext(ctx).Unscoped().Select("id", "name").Where("id IN ?", ids).Find(&clients).Error; err != nil {
+		return nil, err
+	}
+	for _, c := range clients {
+		names[c.ID] = c.Name
+	}
+	return names, nil
+}
+
+// ProfessionalNames devolve o nome de cada profissional, indexado pelo ID
+func (r *bookingRepository) ProfessionalNames(ctx context.Context, ids []uint) (map[uint]string, error) {
+	var professionals []professional.Professional
+	names := make(map[uint]string, len(ids))
+	if len(ids) == 0 {
+		return names, nil
+	}
+	if err := r.db.WithContext(ctx).Select("id", "name").Where("id IN ?", ids).Find(&professionals).Error; err != nil {
+		return nil, err
+	}
+	for _, p := range professionals {
+		names[p.ID] = p.Name
+	}
+	return names, nil
+}
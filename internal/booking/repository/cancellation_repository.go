@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"1mao/internal/booking/domain"
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// GetCancellationPolicy busca a política do profissional; sem política
+// cadastrada, devolve a padrão (cancelamento gratuito)
+func (r *bookingRepository) GetCancellationPolicy(ctx context.Context, professionalID uint) (*domain.CancellationPolicy, error) {
+	var policy domain.CancellationPolicy
+	err := r.db.WithContext(ctx).First(&policy, "professional_id = ?", professionalID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.DefaultCancellationPolicy(professionalID), nil
+		}
+		return nil, err
+	}
+	return &policy, nil
+}
+
+func (r *bookingRepository) SaveCancellationPolicy(ctx context.Context, policy *domain.CancellationPolicy) error {
+	return r.db.WithContext(ctx).Save(policy).Error
+}
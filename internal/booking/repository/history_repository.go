@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"1mao/internal/booking/domain"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// recordStatusEvent grava a mudança de status na transação corrente. O autor
+// vem do contexto (domain.WithActor).
+func recordStatusEvent(ctx context.Context, tx *gorm.DB, booking *domain.Booking, previous domain.BookingStatus, reason string) error {
+	actor := domain.ActorFromContext(ctx)
+	return tx.Create(&domain.BookingEvent{
+		BookingID: booking.ID,
+		ActorID:   actor.ID,
+		ActorRole: actor.Role,
+		OldStatus: previous,
+		NewStatus: booking.Status,
+		Reason:    reason,
+	}).Error
+}
+
+func (r *bookingRepository) ListEvents(ctx context.Context, bookingID uint) ([]*domain.BookingEvent, error) {
+	var events []*domain.BookingEvent
+	err := r.db.WithContext(ctx).
+		Where("booking_id = ?", bookingID).
+		Order("created_at ASC, id ASC").
+		Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (r *bookingRepository) ListHistory(ctx context.Context, bookingID uint) ([]*domain.BookingHistory, error) {
+	var history []*domain.BookingHistory
+	err := r.db.WithContext(ctx).
+		Where("booking_id = ?", bookingID).
+		Order("created_at ASC, id ASC").
+		Find(&history).Error
+	if err != nil {
+		return nil, err
+	}
+	return history, nil
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"1mao/internal/booking/domain"
+	"context"
+	"fmt"
+	"time"
+)
+
+// BookingListQuery descreve uma página da listagem de agendamentos de um
+// profissional ou de um cliente
+type BookingListQuery struct {
+	ProfessionalID uint
+	ClientID       uint
+	From           time.Time
+	To             time.Time
+	Status         domain.BookingStatus
+	Sort           domain.BookingSort
+	After          *domain.BookingCursor // Continua depois desta linha
+	Limit          int
+}
+
+// ListPage busca uma página de agendamentos com filtro de status no banco e
+// paginação por chave (coluna ordenada, id), estável mesmo com inserções
+// entre uma página e outra
+func (r *bookingRepository) ListPage(ctx context.Context, q *BookingListQuery) ([]*domain.Booking, error) {
+	query := r.db.WithContext(ctx)
+
+	if q.ProfessionalID != 0 {
+		query = query.Where("professional_id = ?", q.ProfessionalID)
+	}
+	if q.ClientID != 0 {
+		query = query.Where("client_id = ?", q.ClientID)
+	}
+	if !q.From.IsZero() {
+		query = query.Where("start_time >= ?", q.From)
+	}
+	if !q.To.IsZero() {
+		query = query.Where("end_time <= ?", q.To)
+	}
+	if q.Status != "" {
+		query = query.Where("status = ?", q.Status)
+	}
+
+	// Colunas vêm de domain.BookingSort, validada pelo service
+	column, desc := q.Sort.Column()
+	direction, comparison := "ASC", ">"
+	if desc {
+		direction, comparison = "DESC", "<"
+	}
+	if q.After != nil {
+		query = query.Where(fmt.Sprintf("(%s, id) %s (?, ?)", column, comparison), q.After.Key, q.After.ID)
+	}
+
+	var bookings []*domain.Booking
+	err := query.
+		Order(fmt.Sprintf("%s %s, id %s", column, direction, direction)).
+		Limit(q.Limit).
+		Find(&bookings).Error
+	if err != nil {
+		return nil, err
+	}
+	return bookings, nil
+}
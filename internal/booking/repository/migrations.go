@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"1mao/internal/booking/domain"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+const (
+	pgUniqueViolation    = "23505"
+	pgExclusionViolation = "23P01"
+
+	bookingOverlapConstraint = "bookings_no_overlap"
+)
+
+// Migrate cria as restrições de banco que o AutoMigrate não cobre. Deve ser
+// chamado depois do AutoMigrate de domain.Booking.
+func Migrate(db *gorm.DB) error {
+	if err := db.Exec("CREATE EXTENSION IF NOT EXISTS btree_gist").Error; err != nil {
+		return fmt.Errorf("erro ao criar extensão btree_gist: %w", err)
+	}
+
+	// Índices da paginação por chave das listagens (ListPage)
+	for _, stmt := range []string{
+		"CREATE INDEX IF NOT EXISTS idx_bookings_professional_start ON bookings (professional_id, start_time, id)",
+		"CREATE INDEX IF NOT EXISTS idx_bookings_client_start ON bookings (client_id, start_time, id)",
+	} {
+		if err := db.Exec(stmt).Error; err != nil {
+			return fmt.Errorf("erro ao criar índice de listagem: %w", err)
+		}
+	}
+
+	// Impede dois agendamentos ativos sobrepostos para o mesmo profissional.
+	// A lista de status fica no comentário da restrição; se domain.ActiveStatuses
+	// mudar, a restrição é recriada.
+	names := make([]string, 0, len(domain.ActiveStatuses))
+	literals := make([]string, 0, len(domain.ActiveStatuses))
+	for _, s := range domain.ActiveStatuses {
+		names = append(names, string(s))
+		literals = append(literals, fmt.Sprintf("'%s'", s))
+	}
+	expected := strings.Join(names, ",")
+
+	var current sql.NullString
+	err := db.Raw(
+		"SELECT obj_description(oid, 'pg_constraint') FROM pg_constraint WHERE conname = ?",
+		bookingOverlapConstraint,
+	).Row().Scan(&current)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("erro ao consultar restrição %s: %w", bookingOverlapConstraint, err)
+	}
+	if err == nil && current.Valid && current.String == expected {
+		return nil
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(fmt.Sprintf(
+			"ALTER TABLE bookings DROP CONSTRAINT IF EXISTS %s", bookingOverlapConstraint,
+		)).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec(fmt.Sprintf(`
+			ALTER TABLE bookings ADD CONSTRAINT %[1]s EXCLUDE USING gist (
+				professional_id WITH =,
+				tstzrange(start_time, end_time, '[)') WITH &&
+			) WHERE (status IN (%[2]s))`,
+			bookingOverlapConstraint, strings.Join(literals, ", "),
+		)).Error; err != nil {
+			return err
+		}
+		return tx.Exec(fmt.Sprintf(
+			"COMMENT ON CONSTRAINT %s ON bookings IS '%s'", bookingOverlapConstraint, expected,
+		)).Error
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao criar restrição %s: %w", bookingOverlapConstraint, err)
+	}
+	return nil
+}
+
+// translateConflict converte violações de unicidade/exclusão do Postgres em
+// domain.ErrTimeSlotUnavailable
+func translateConflict(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case pgUniqueViolation, pgExclusionViolation:
+			return domain.ErrTimeSlotUnavailable
+		}
+	}
+	return err
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"1mao/internal/booking/domain"
+	professional "1mao/internal/professional/domain"
+	"1mao/pkg/geo"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -44,8 +46,8 @@ func (m *MockBookingRepository) ListByClient(ctx context.Context, clientID uint)
 	return args.Get(0).([]*domain.Booking), args.Error(1)
 }
 
-func (m *MockBookingRepository) UpdateStatus(ctx context.Context, id uint, status domain.BookingStatus) (*domain.Booking, error) {
-	args := m.Called(ctx, id, status)
+func (m *MockBookingRepository) UpdateStatus(ctx context.Context, id uint, status domain.BookingStatus, reason string) (*domain.Booking, error) {
+	args := m.Called(ctx, id, status, reason)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -56,3 +58,283 @@ func (m *MockBookingRepository) IsTimeSlotAvailable( ctx context.Context, profes
 	args := m.Called(ctx, professionalID, start, end)
 	return args.Bool(0), args.Error(1)
 }
+
+
+func (m *MockBookingRepository) ListAvailability(ctx context.Context, professionalID uint) ([]*domain.Availability, error) {
+	args := m.Called(ctx, professionalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Availability), args.Error(1)
+}
+
+func (m *MockBookingRepository) GetAvailability(ctx context.Context, id uint) (*domain.Availability, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Availability), args.Error(1)
+}
+
+func (m *MockBookingRepository) CreateAvailability(ctx context.Context, availability *domain.Availability) error {
+	args := m.Called(ctx, availability)
+	return args.Error(0)
+}
+
+func (m *MockBookingRepository) UpdateAvailability(ctx context.Context, availability *domain.Availability) error {
+	args := m.Called(ctx, availability)
+	return args.Error(0)
+}
+
+func (m *MockBookingRepository) DeleteAvailability(ctx context.Context, id uint) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockBookingRepository) GetServiceOffering(ctx context.Context, id uint) (*professional.ServiceOffering, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*professional.ServiceOffering), args.Error(1)
+}
+
+func (m *MockBookingRepository) CreateSeries(ctx context.Context, series *domain.BookingSeries, occurrences []*CreateBookingRequest) ([]*domain.Booking, error) {
+	args := m.Called(ctx, series, occurrences)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Booking), args.Error(1)
+}
+
+func (m *MockBookingRepository) CancelSeries(ctx context.Context, seriesID uint, from time.Time, reason string) ([]*domain.Booking, error) {
+	args := m.Called(ctx, seriesID, from, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Booking), args.Error(1)
+}
+
+func (m *MockBookingRepository) Reschedule(ctx context.Context, id uint, start, end time.Time, actor domain.Actor) (*domain.Booking, error) {
+	args := m.Called(ctx, id, start, end, actor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Booking), args.Error(1)
+}
+
+func (m *MockBookingRepository) ListEvents(ctx context.Context, bookingID uint) ([]*domain.BookingEvent, error) {
+	args := m.Called(ctx, bookingID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.BookingEvent), args.Error(1)
+}
+
+func (m *MockBookingRepository) ListHistory(ctx context.Context, bookingID uint) ([]*domain.BookingHistory, error) {
+	args := m.Called(ctx, bookingID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.BookingHistory), args.Error(1)
+}
+
+func (m *MockBookingRepository) GetCancellationPolicy(ctx context.Context, professionalID uint) (*domain.CancellationPolicy, error) {
+	args := m.Called(ctx, professionalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CancellationPolicy), args.Error(1)
+}
+
+func (m *MockBookingRepository) SaveCancellationPolicy(ctx context.Context, policy *domain.CancellationPolicy) error {
+	args := m.Called(ctx, policy)
+	return args.Error(0)
+}
+
+func (m *MockBookingRepository) ExpirePending(ctx context.Context, createdBefore time.Time) ([]*domain.Booking, error) {
+	args := m.Called(ctx, createdBefore)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Booking), args.Error(1)
+}
+
+func (m *MockBookingRepository) ListTimeOff(ctx context.Context, professionalID uint, from, to time.Time) ([]*domain.TimeOff, error) {
+	args := m.Called(ctx, professionalID, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TimeOff), args.Error(1)
+}
+
+func (m *MockBookingRepository) GetTimeOff(ctx context.Context, id uint) (*domain.TimeOff, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TimeOff), args.Error(1)
+}
+
+func (m *MockBookingRepository) CreateTimeOff(ctx context.Context, timeOff *domain.TimeOff, force bool) ([]*domain.Booking, error) {
+	args := m.Called(ctx, timeOff, force)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Booking), args.Error(1)
+}
+
+func (m *MockBookingRepository) DeleteTimeOff(ctx context.Context, id uint) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockBookingRepository) GetCalendarToken(ctx context.Context, professionalID uint) (*domain.CalendarToken, error) {
+	args := m.Called(ctx, professionalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CalendarToken), args.Error(1)
+}
+
+func (m *MockBookingRepository) SaveCalendarToken(ctx context.Context, token *domain.CalendarToken) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+func (m *MockBookingRepository) ClientNames(ctx context.Context, ids []uint) (map[uint]string, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uint]string), args.Error(1)
+}
+
+func (m *MockBookingRepository) ProfessionalNames(ctx context.Context, ids []uint) (map[uint]string, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uint]string), args.Error(1)
+}
+
+func (m *MockBookingRepository) ProfessionalTimeZone(ctx context.Context, professionalID uint) (string, error) {
+	args := m.Called(ctx, professionalID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockBookingRepository) ClientTimeZone(ctx context.Context, clientID uint) (string, error) {
+	args := m.Called(ctx, clientID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockBookingRepository) ListPage(ctx context.Context, query *BookingListQuery) ([]*domain.Booking, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Booking), args.Error(1)
+}
+
+func (m *MockBookingRepository) CreateWaitlistEntry(ctx context.Context, entry *domain.WaitlistEntry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+func (m *MockBookingRepository) GetWaitlistEntry(ctx context.Context, id uint) (*domain.WaitlistEntry, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WaitlistEntry), args.Error(1)
+}
+
+func (m *MockBookingRepository) ListWaitlistByClient(ctx context.Context, clientID uint) ([]*domain.WaitlistEntry, error) {
+	args := m.Called(ctx, clientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.WaitlistEntry), args.Error(1)
+}
+
+func (m *MockBookingRepository) CancelWaitlistEntry(ctx context.Context, id uint) (*domain.WaitlistEntry, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WaitlistEntry), args.Error(1)
+}
+
+func (m *MockBookingRepository) OfferWaitlistSlot(ctx context.Context, offer domain.WaitlistOffer, holdUntil time.Time) (*domain.WaitlistEntry, error) {
+	args := m.Called(ctx, offer, holdUntil)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WaitlistEntry), args.Error(1)
+}
+
+func (m *MockBookingRepository) ExpireWaitlistHolds(ctx context.Context, now time.Time) ([]*domain.WaitlistEntry, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.WaitlistEntry), args.Error(1)
+}
+
+func (m *MockBookingRepository) AcceptWaitlistOffer(ctx context.Context, id uint, now time.Time) (*domain.Booking, error) {
+	args := m.Called(ctx, id, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Booking), args.Error(1)
+}
+
+func (m *MockBookingRepository) CreateReview(ctx context.Context, review *domain.Review) error {
+	args := m.Called(ctx, review)
+	return args.Error(0)
+}
+
+func (m *MockBookingRepository) GetReview(ctx context.Context, id uint) (*domain.Review, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Review), args.Error(1)
+}
+
+func (m *MockBookingRepository) ReplyToReview(ctx context.Context, id uint, reply string) (*domain.Review, error) {
+	args := m.Called(ctx, id, reply)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Review), args.Error(1)
+}
+
+func (m *MockBookingRepository) ListReviews(ctx context.Context, professionalID uint, after *domain.ReviewCursor, limit int) ([]*domain.Review, error) {
+	args := m.Called(ctx, professionalID, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Review), args.Error(1)
+}
+
+func (m *MockBookingRepository) ProfessionalServiceArea(ctx context.Context, professionalID uint) (*geo.ServiceArea, error) {
+	args := m.Called(ctx, professionalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*geo.ServiceArea), args.Error(1)
+}
+
+func (m *MockBookingRepository) EmailVerified(ctx context.Context, role string, id uint) (bool, error) {
+	args := m.Called(ctx, role, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBookingRepository) ClientContact(ctx context.Context, clientID uint) (*domain.Contact, error) {
+	args := m.Called(ctx, clientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Contact), args.Error(1)
+}
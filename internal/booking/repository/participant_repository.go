@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"1mao/internal/booking/domain"
+	client "1mao/internal/client/domain"
+	professional "1mao/internal/professional/domain"
+	"1mao/pkg/geo"
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// ProfessionalTimeZone devolve o fuso IANA do profissional
+func (r *bookingRepository) ProfessionalTimeZone(ctx context.Context, professionalID uint) (string, error) {
+	var p professional.Professional
+	err := r.db.WithContext(ctx).Select("id", "time_zone").First(&p, professionalID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", domain.ErrProfessionalUnavailable
+		}
+		return "", err
+	}
+	return p.TimeZone, nil
+}
+
+// ProfessionalServiceArea devolve a região atendida pelo profissional
+func (r *bookingRepository) ProfessionalServiceArea(ctx context.Context, professionalID uint) (*geo.ServiceArea, error) {
+	var p professional.Professional
+	err := r.db.WithContext(ctx).
+		Select("id", "address_latitude", "address_longitude", "service_radius_km", "service_postal_codes").
+		First(&p, professionalID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrProfessionalUnavailable
+		}
+		return nil, err
+	}
+	area := p.ServiceArea()
+	return &area, nil
+}
+
+// ClientTimeZone devolve o fuso IANA do cliente; vazio se o cliente não existir
+func (r *bookingRepository) ClientTimeZone(ctx context.Context, clientID uint) (string, error) {
+	var c client.Client
+	err := r.db.WithContext(ctx).Select("id", "time_zone").First(&c, clientID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return c.TimeZone, nil
+}
+
+// EmailVerified informa se a conta (cliente ou profissional, conforme role)
+// já confirmou o e-mail. Conta inexistente ou excluída conta como não verificada.
+func (r *bookingRepository) EmailVerified(ctx context.Context, role string, id uint) (bool, error) {
+	var model interface{}
+	switch role {
+	case domain.RoleClient:
+		model = &client.Client{}
+	case domain.RoleProfessional:
+		model = &professional.Professional{}
+	default:
+		return false, nil
+	}
+
+	var count int64
+	err := r.db.WithContext(ctx).Model(model).
+		Where("id = ? AND email_verified_at IS NOT NULL", id).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// ClientContact devolve nome, e-mail, idioma e fuso do cliente; nil se o
+// cliente não existir
+func (r *bookingRepository) ClientContact(ctx context.Context, clientID uint) (*domain.Contact, error) {
+	var c client.Client
+	err := r.db.WithContext(ctx).Select("id", "name", "email", "locale", "time_zone").First(&c, clientID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &domain.Contact{Name: c.Name, Email: c.Email, Locale: c.Locale, TimeZone: c.TimeZone}, nil
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"1mao/internal/booking/domain"
+	professional "1mao/internal/professional/domain"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreateReview grava a avaliação e atualiza a média do profissional na
+// mesma transação, sem recalcular sobre todas as avaliações
+func (r *bookingRepository) CreateReview(ctx context.Context, review *domain.Review) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(review).Error; err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+				return domain.ErrReviewExists
+			}
+			return err
+		}
+
+		return tx.Model(&professional.Professional{}).
+			Where("id = ?", review.ProfessionalID).
+			Updates(map[string]interface{}{
+				"rating":       gorm.Expr("(rating * review_count + ?) / (review_count + 1)", review.Rating),
+				"review_count": gorm.Expr("review_count + 1"),
+			}).Error
+	})
+}
+
+func (r *bookingRepository) GetReview(ctx context.Context, id uint) (*domain.Review, error) {
+	var review domain.Review
+	err := r.db.WithContext(ctx).First(&review, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrReviewNotFound
+		}
+		return nil, err
+	}
+	return &review, nil
+}
+
+// ReplyToReview grava a resposta do profissional; só é possível responder uma vez
+func (r *bookingRepository) ReplyToReview(ctx context.Context, id uint, reply string) (*domain.Review, error) {
+	var review domain.Review
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&review, id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrReviewNotFound
+			}
+			return err
+		}
+		if review.RepliedAt != nil {
+			return domain.ErrReviewAlreadyReplied
+		}
+
+		now := time.Now()
+		review.Reply = reply
+		review.RepliedAt = &now
+		review.UpdatedAt = now
+		return tx.Save(&review).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+// ListReviews lista as avaliações do profissional, mais recentes primeiro,
+// continuando depois de after quando informado
+func (r *bookingRepository) ListReviews(ctx context.Context, professionalID uint, after *domain.ReviewCursor, limit int) ([]*domain.Review, error) {
+	query := r.db.WithContext(ctx).Where("professional_id = ?", professionalID)
+	if after != nil {
+		query = query.Where("(created_at, id) < (?, ?)", after.CreatedAt, after.ID)
+	}
+
+	var reviews []*domain.Review
+	err := query.Order("created_at DESC, id DESC").Limit(limit).Find(&reviews).Error
+	if err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
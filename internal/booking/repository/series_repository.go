@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"1mao/internal/booking/domain"
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreateSeries cria a série e todas as ocorrências numa única transação.
+// Cada ocorrência é inserida num savepoint para que todos os conflitos sejam
+// reportados; se houver algum, nada é gravado e um *domain.SeriesConflictError
+// é retornado.
+func (r *bookingRepository) CreateSeries(ctx context.Context, series *domain.BookingSeries, occurrences []*CreateBookingRequest) ([]*domain.Booking, error) {
+	var bookings []*domain.Booking
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := ensureProfessionalExists(tx, series.ProfessionalID); err != nil {
+			return err
+		}
+		if err := tx.Create(series).Error; err != nil {
+			return err
+		}
+
+		var conflicts []domain.OccurrenceConflict
+		for _, occurrence := range occurrences {
+			booking := newBooking(occurrence)
+			booking.SeriesID = &series.ID
+
+			err := tx.Transaction(func(sp *gorm.DB) error {
+				available, err := isTimeSlotAvailable(sp, booking.ProfessionalID, booking.StartTime, booking.EndTime)
+				if err != nil {
+					return err
+				}
+				if !available {
+					return domain.ErrTimeSlotUnavailable
+				}
+				if err := translateConflict(sp.Create(booking).Error); err != nil {
+					return err
+				}
+				return recordStatusEvent(ctx, sp, booking, "", "")
+			})
+			if errors.Is(err, domain.ErrTimeSlotUnavailable) {
+				conflicts = append(conflicts, domain.OccurrenceConflict{
+					StartTime: booking.StartTime,
+					EndTime:   booking.EndTime,
+					Reason:    err.Error(),
+				})
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			bookings = append(bookings, booking)
+		}
+
+		if len(conflicts) > 0 {
+			return &domain.SeriesConflictError{Conflicts: conflicts}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bookings, nil
+}
+
+// CancelSeries cancela as ocorrências ativas da série que começam a partir de from
+func (r *bookingRepository) CancelSeries(ctx context.Context, seriesID uint, from time.Time, reason string) ([]*domain.Booking, error) {
+	var bookings []*domain.Booking
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("series_id = ?", seriesID).
+			Where("status IN ?", []domain.BookingStatus{domain.StatusPending, domain.StatusConfirmed}).
+			Where("start_time >= ?", from).
+			Order("start_time ASC").
+			Find(&bookings).Error; err != nil {
+			return err
+		}
+
+		now := time.Now()
+		for _, booking := range bookings {
+			if !domain.CanTransition(booking.Status, domain.StatusCancelled) {
+				return domain.ErrInvalidStatusTransition
+			}
+			previous := booking.Status
+			booking.Status = domain.StatusCancelled
+			booking.UpdatedAt = now
+			if err := tx.Save(booking).Error; err != nil {
+				return err
+			}
+			if err := recordStatusEvent(ctx, tx, booking, previous, reason); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bookings, nil
+}
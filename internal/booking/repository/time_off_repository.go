@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"1mao/internal/booking/domain"
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// overlapsTimeOff indica se o profissional está de folga em algum momento do intervalo
+func overlapsTimeOff(db *gorm.DB, professionalID uint, start, end time.Time) (bool, error) {
+	var count int64
+	err := db.Model(&domain.TimeOff{}).
+		Where("professional_id = ?", professionalID).
+		Where("start_time < ? AND end_time > ?", end, start).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// ListTimeOff lista as folgas que colidem com [from, to); limites zero são ignorados
+func (r *bookingRepository) ListTimeOff(ctx context.Context, professionalID uint, from, to time.Time) ([]*domain.TimeOff, error) {
+	var timeOff []*domain.TimeOff
+
+	query := r.db.WithContext(ctx).Where("professional_id = ?", professionalID)
+	if !from.IsZero() {
+		query = query.Where("end_time > ?", from)
+	}
+	if !to.IsZero() {
+		query = query.Where("start_time < ?", to)
+	}
+	if err := query.Order("start_time ASC").Find(&timeOff).Error; err != nil {
+		return nil, err
+	}
+	return timeOff, nil
+}
+
+func (r *bookingRepository) GetTimeOff(ctx context.Context, id uint) (*domain.TimeOff, error) {
+	var timeOff domain.TimeOff
+	err := r.db.WithContext(ctx).First(&timeOff, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrTimeOffNotFound
+		}
+		return nil, err
+	}
+	return &timeOff, nil
+}
+
+// CreateTimeOff grava a folga. Agendamentos ativos no período impedem a
+// gravação (*domain.TimeOffConflictError), a menos que force seja verdadeiro:
+// nesse caso eles são cancelados na mesma transação e devolvidos.
+func (r *bookingRepository) CreateTimeOff(ctx context.Context, timeOff *domain.TimeOff, force bool) ([]*domain.Booking, error) {
+	var affected []*domain.Booking
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("professional_id = ?", timeOff.ProfessionalID).
+			Where("status IN ?", domain.ActiveStatuses).
+			Where("start_time < ? AND end_time > ?", timeOff.EndTime, timeOff.StartTime).
+			Order("start_time ASC").
+			Find(&affected).Error; err != nil {
+			return err
+		}
+
+		if len(affected) > 0 && !force {
+			return &domain.TimeOffConflictError{Bookings: affected}
+		}
+
+		now := time.Now()
+		for _, booking := range affected {
+			if !domain.CanTransition(booking.Status, domain.StatusCancelled) {
+				return &domain.TimeOffConflictError{Bookings: []*domain.Booking{booking}}
+			}
+			previous := booking.Status
+			booking.Status = domain.StatusCancelled
+			booking.UpdatedAt = now
+			if err := tx.Save(booking).Error; err != nil {
+				return err
+			}
+			if err := recordStatusEvent(ctx, tx, booking, previous, "profissional indisponível: "+timeOff.Reason); err != nil {
+				return err
+			}
+		}
+
+		return tx.Create(timeOff).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return affected, nil
+}
+
+func (r *bookingRepository) DeleteTimeOff(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&domain.TimeOff{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrTimeOffNotFound
+	}
+	return nil
+}
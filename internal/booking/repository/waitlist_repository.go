@@ -0,0 +1,219 @@
+package repository
+
+import (
+	"1mao/internal/booking/domain"
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// overlapsHold indica se [start, end) colide com um horário reservado para
+// um cliente da lista de espera
+func overlapsHold(db *gorm.DB, professionalID uint, start, end time.Time) (bool, error) {
+	var count int64
+	err := db.Model(&domain.WaitlistEntry{}).
+		Where("professional_id = ?", professionalID).
+		Where("status = ?", domain.WaitlistOffered).
+		Where("hold_expires_at > ?", time.Now()).
+		Where("offered_start < ? AND offered_end > ?", end, start).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *bookingRepository) CreateWaitlistEntry(ctx context.Context, entry *domain.WaitlistEntry) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := ensureProfessionalExists(tx, entry.ProfessionalID); err != nil {
+			return err
+		}
+		return tx.Create(entry).Error
+	})
+}
+
+func (r *bookingRepository) GetWaitlistEntry(ctx context.Context, id uint) (*domain.WaitlistEntry, error) {
+	var entry domain.WaitlistEntry
+	err := r.db.WithContext(ctx).First(&entry, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrWaitlistEntryNotFound
+		}
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// ListWaitlistByClient lista as inscrições do cliente, mais recentes primeiro
+func (r *bookingRepository) ListWaitlistByClient(ctx context.Context, clientID uint) ([]*domain.WaitlistEntry, error) {
+	var entries []*domain.WaitlistEntry
+	err := r.db.WithContext(ctx).
+		Where("client_id = ?", clientID).
+		Order("created_at DESC, id DESC").
+		Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// CancelWaitlistEntry tira o cliente da lista. A inscrição é devolvida com a
+// oferta que ela tinha, para que o horário reservado seja repassado.
+func (r *bookingRepository) CancelWaitlistEntry(ctx context.Context, id uint) (*domain.WaitlistEntry, error) {
+	var entry domain.WaitlistEntry
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockWaitlistEntry(tx, id, &entry); err != nil {
+			return err
+		}
+		if !entry.IsOpen() {
+			return domain.ErrWaitlistOfferUnavailable
+		}
+		entry.Status = domain.WaitlistCancelled
+		entry.UpdatedAt = time.Now()
+		return tx.Save(&entry).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// OfferWaitlistSlot reserva o horário da oferta até holdUntil para o cliente
+// mais antigo da lista cujo intervalo desejado cobre o horário. Retorna nil se
+// o horário não estiver mais livre ou se ninguém estiver esperando por ele.
+func (r *bookingRepository) OfferWaitlistSlot(ctx context.Context, offer domain.WaitlistOffer, holdUntil time.Time) (*domain.WaitlistEntry, error) {
+	var offered *domain.WaitlistEntry
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		available, err := isTimeSlotAvailable(tx, offer.ProfessionalID, offer.Start, offer.End)
+		if err != nil || !available {
+			return err
+		}
+
+		// SKIP LOCKED: ofertas concorrentes pegam clientes diferentes
+		var entry domain.WaitlistEntry
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("professional_id = ?", offer.ProfessionalID).
+			Where("status = ?", domain.WaitlistWaiting).
+			Where("start_time <= ? AND end_time >= ?", offer.Start, offer.End).
+			Order("created_at ASC, id ASC").
+			First(&entry).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		entry.Status = domain.WaitlistOffered
+		entry.OfferedStart = &offer.Start
+		entry.OfferedEnd = &offer.End
+		entry.OfferedServiceID = offer.ServiceID
+		entry.OfferedPriceCents = offer.PriceCents
+		entry.OfferedCurrency = offer.Currency
+		entry.HoldExpiresAt = &holdUntil
+		entry.UpdatedAt = time.Now()
+		if err := tx.Save(&entry).Error; err != nil {
+			return err
+		}
+		offered = &entry
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return offered, nil
+}
+
+// ExpireWaitlistHolds marca como expiradas as ofertas cuja reserva venceu
+// antes de now e as devolve para que o horário seja repassado
+func (r *bookingRepository) ExpireWaitlistHolds(ctx context.Context, now time.Time) ([]*domain.WaitlistEntry, error) {
+	var entries []*domain.WaitlistEntry
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ?", domain.WaitlistOffered).
+			Where("hold_expires_at <= ?", now).
+			Find(&entries).Error; err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			entry.Status = domain.WaitlistExpired
+			entry.UpdatedAt = now
+			if err := tx.Save(entry).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// AcceptWaitlistOffer cria o agendamento do horário oferecido e fecha a
+// inscrição na mesma transação. A reserva precisa estar valendo em now.
+func (r *bookingRepository) AcceptWaitlistOffer(ctx context.Context, id uint, now time.Time) (*domain.Booking, error) {
+	var booking *domain.Booking
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var entry domain.WaitlistEntry
+		if err := lockWaitlistEntry(tx, id, &entry); err != nil {
+			return err
+		}
+		if !entry.HoldActive(now) {
+			return domain.ErrWaitlistOfferUnavailable
+		}
+
+		booking = waitlistBooking(&entry)
+
+		onTimeOff, err := overlapsTimeOff(tx, booking.ProfessionalID, booking.StartTime, booking.EndTime)
+		if err != nil {
+			return err
+		}
+		if onTimeOff {
+			return domain.ErrTimeSlotUnavailable
+		}
+		if err := translateConflict(tx.Create(booking).Error); err != nil {
+			return err
+		}
+		if err := recordStatusEvent(ctx, tx, booking, "", "lista de espera"); err != nil {
+			return err
+		}
+
+		entry.Status = domain.WaitlistBooked
+		entry.BookingID = &booking.ID
+		entry.UpdatedAt = now
+		return tx.Save(&entry).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return booking, nil
+}
+
+// waitlistBooking monta o agendamento da oferta aceita, com o serviço e o
+// preço do agendamento que liberou o horário
+func waitlistBooking(entry *domain.WaitlistEntry) *domain.Booking {
+	return newBooking(&CreateBookingRequest{
+		ProfessionalID: entry.ProfessionalID,
+		ClientID:       entry.ClientID,
+		StartTime:      *entry.OfferedStart,
+		EndTime:        *entry.OfferedEnd,
+		ServiceID:      entry.OfferedServiceID,
+		PriceCents:     entry.OfferedPriceCents,
+		Currency:       entry.OfferedCurrency,
+		ServiceAddress: entry.ServiceAddress,
+	})
+}
+
+func lockWaitlistEntry(tx *gorm.DB, id uint, entry *domain.WaitlistEntry) error {
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(entry, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.ErrWaitlistEntryNotFound
+	}
+	return err
+}
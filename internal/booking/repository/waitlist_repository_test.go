@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"1mao/internal/booking/domain"
+	"1mao/pkg/geo"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitlistBooking_CopiesOfferedTerms(t *testing.T) {
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Minute)
+	end := start.Add(time.Hour)
+	serviceID := uint(6)
+	entry := &domain.WaitlistEntry{
+		ID:                8,
+		ProfessionalID:    1,
+		ClientID:          3,
+		Status:            domain.WaitlistOffered,
+		OfferedStart:      &start,
+		OfferedEnd:        &end,
+		OfferedServiceID:  &serviceID,
+		OfferedPriceCents: 12000,
+		OfferedCurrency:   "BRL",
+		ServiceAddress:    geo.Address{PostalCode: "01310100"},
+	}
+
+	booking := waitlistBooking(entry)
+
+	assert.Equal(t, uint(1), booking.ProfessionalID)
+	assert.Equal(t, uint(3), booking.ClientID)
+	assert.Equal(t, start, booking.StartTime)
+	assert.Equal(t, end, booking.EndTime)
+	assert.Equal(t, domain.StatusPending, booking.Status)
+	assert.Equal(t, &serviceID, booking.ServiceID)
+	assert.Equal(t, int64(12000), booking.PriceCents)
+	assert.Equal(t, "BRL", booking.Currency)
+	assert.Equal(t, "01310100", booking.ServiceAddress.PostalCode)
+}
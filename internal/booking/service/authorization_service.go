@@ -0,0 +1,52 @@
+package service
+
+import (
+	"1mao/internal/booking/domain"
+	"context"
+)
+
+// authorizedBooking carrega o agendamento e garante que o autor da operação
+// (domain.WithActor) participa dele
+func (s *bookingService) authorizedBooking(ctx context.Context, id uint) (*domain.Booking, error) {
+	booking, err := s.bookingRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !domain.ActorFromContext(ctx).Participates(booking) {
+		return nil, domain.ErrForbidden
+	}
+	return booking, nil
+}
+
+// authorizeStatus garante que o autor da operação pode levar o agendamento ao status next
+func authorizeStatus(ctx context.Context, booking *domain.Booking, next domain.BookingStatus) error {
+	if !domain.ActorFromContext(ctx).CanSetStatus(booking, next) {
+		return domain.ErrForbidden
+	}
+	return nil
+}
+
+// requireVerifiedEmail exige e-mail confirmado do cliente e, quando é o
+// profissional quem agenda, também do profissional
+func (s *bookingService) requireVerifiedEmail(ctx context.Context, clientID uint) error {
+	verified, err := s.bookingRepo.EmailVerified(ctx, domain.RoleClient, clientID)
+	if err != nil {
+		return err
+	}
+	if !verified {
+		return domain.ErrEmailNotVerified
+	}
+
+	actor := domain.ActorFromContext(ctx)
+	if actor.Role != domain.RoleProfessional {
+		return nil
+	}
+	verified, err = s.bookingRepo.EmailVerified(ctx, domain.RoleProfessional, actor.ID)
+	if err != nil {
+		return err
+	}
+	if !verified {
+		return domain.ErrEmailNotVerified
+	}
+	return nil
+}
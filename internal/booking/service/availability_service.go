@@ -0,0 +1,173 @@
+package service
+
+import (
+	"1mao/internal/booking/domain"
+	"context"
+	"time"
+)
+
+// AvailabilityRequest define o payload para criar ou alterar uma janela de atendimento
+// @Model AvailabilityRequest
+type AvailabilityRequest struct {
+	Weekday   time.Weekday `json:"weekday" example:"1"`
+	StartTime string       `json:"start_time" example:"08:30"`
+	EndTime   string       `json:"end_time" example:"12:00"`
+}
+
+// AvailabilityResponse define a resposta de uma janela de atendimento
+// @Model AvailabilityResponse
+type AvailabilityResponse struct {
+	ID             uint         `json:"id"`
+	ProfessionalID uint         `json:"professional_id"`
+	Weekday        time.Weekday `json:"weekday"`
+	StartTime      string       `json:"start_time"`
+	EndTime        string       `json:"end_time"`
+}
+
+func (s *bookingService) ListAvailability(ctx context.Context, professionalID uint) ([]*AvailabilityResponse, error) {
+	windows, err := s.bookingRepo.ListAvailability(ctx, professionalID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]*AvailabilityResponse, 0, len(windows))
+	for _, w := range windows {
+		response = append(response, toAvailabilityResponse(w))
+	}
+	return response, nil
+}
+
+func (s *bookingService) CreateAvailability(ctx context.Context, professionalID uint, req *AvailabilityRequest) (*AvailabilityResponse, error) {
+	availability := &domain.Availability{ProfessionalID: professionalID}
+	if err := applyAvailabilityRequest(availability, req); err != nil {
+		return nil, err
+	}
+	if err := s.checkAvailabilityOverlap(ctx, availability); err != nil {
+		return nil, err
+	}
+
+	if err := s.bookingRepo.CreateAvailability(ctx, availability); err != nil {
+		return nil, err
+	}
+	return toAvailabilityResponse(availability), nil
+}
+
+func (s *bookingService) UpdateAvailability(ctx context.Context, professionalID, id uint, req *AvailabilityRequest) (*AvailabilityResponse, error) {
+	availability, err := s.getOwnedAvailability(ctx, professionalID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyAvailabilityRequest(availability, req); err != nil {
+		return nil, err
+	}
+	if err := s.checkAvailabilityOverlap(ctx, availability); err != nil {
+		return nil, err
+	}
+
+	if err := s.bookingRepo.UpdateAvailability(ctx, availability); err != nil {
+		return nil, err
+	}
+	return toAvailabilityResponse(availability), nil
+}
+
+func (s *bookingService) DeleteAvailability(ctx context.Context, professionalID, id uint) error {
+	if _, err := s.getOwnedAvailability(ctx, professionalID, id); err != nil {
+		return err
+	}
+	return s.bookingRepo.DeleteAvailability(ctx, id)
+}
+
+// getOwnedAvailability busca a janela garantindo que pertence ao profissional
+func (s *bookingService) getOwnedAvailability(ctx context.Context, professionalID, id uint) (*domain.Availability, error) {
+	availability, err := s.bookingRepo.GetAvailability(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if availability.ProfessionalID != professionalID {
+		return nil, domain.ErrAvailabilityNotFound
+	}
+	return availability, nil
+}
+
+// checkAvailabilityOverlap impede janelas sobrepostas no mesmo dia
+func (s *bookingService) checkAvailabilityOverlap(ctx context.Context, availability *domain.Availability) error {
+	windows, err := s.bookingRepo.ListAvailability(ctx, availability.ProfessionalID)
+	if err != nil {
+		return err
+	}
+	for _, w := range windows {
+		if w.ID != availability.ID && w.Overlaps(availability) {
+			return domain.ErrInvalidAvailability
+		}
+	}
+	return nil
+}
+
+func applyAvailabilityRequest(availability *domain.Availability, req *AvailabilityRequest) error {
+	start, err := domain.ParseClock(req.StartTime)
+	if err != nil {
+		return err
+	}
+	end, err := domain.ParseClock(req.EndTime)
+	if err != nil {
+		return err
+	}
+
+	availability.Weekday = req.Weekday
+	availability.StartMinute = start
+	availability.EndMinute = end
+	return availability.Validate()
+}
+
+func toAvailabilityResponse(availability *domain.Availability) *AvailabilityResponse {
+	return &AvailabilityResponse{
+		ID:             availability.ID,
+		ProfessionalID: availability.ProfessionalID,
+		Weekday:        availability.Weekday,
+		StartTime:      domain.FormatClock(availability.StartMinute),
+		EndTime:        domain.FormatClock(availability.EndMinute),
+	}
+}
+
+// SlotResponse define um horário livre para agendamento
+// @Model SlotResponse
+type SlotResponse struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
+
+func (s *bookingService) ListAvailableSlots(ctx context.Context, professionalID uint, from, to time.Time, duration time.Duration) ([]*SlotResponse, error) {
+	if duration <= 0 || !from.Before(to) || to.Sub(from) > domain.MaxSlotSearchRange {
+		return nil, domain.ErrInvalidSlotQuery
+	}
+
+	// Os dias são percorridos no relógio local do profissional
+	windows, loc, err := s.professionalSchedule(ctx, professionalID)
+	if err != nil {
+		return nil, err
+	}
+	from, to = from.In(loc), to.In(loc)
+
+	// Não oferece horários no passado
+	if now := time.Now().In(loc); from.Before(now) {
+		from = now
+	}
+
+	// Amplia a busca em um dia para pegar agendamentos que cruzam os limites
+	bookings, err := s.bookingRepo.ListByProfessional(ctx, professionalID, from.AddDate(0, 0, -1), to.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	timeOff, err := s.bookingRepo.ListTimeOff(ctx, professionalID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	slots := domain.FreeSlots(windows, bookings, timeOff, from, to, duration)
+	response := make([]*SlotResponse, 0, len(slots))
+	for _, slot := range slots {
+		response = append(response, &SlotResponse{StartTime: slot.Start, EndTime: slot.End})
+	}
+	return response, nil
+}
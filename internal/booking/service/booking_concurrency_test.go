@@ -0,0 +1,112 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"1mao/internal/booking/domain"
+	"1mao/internal/booking/repository"
+	"1mao/internal/booking/service"
+	professional "1mao/internal/professional/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// Teste de integração: requer um Postgres acessível em TEST_DATABASE_DSN
+// (ex: "host=localhost user=postgres password=postgres dbname=1mao_test sslmode=disable")
+func TestBookingService_CreateBooking_Concurrent(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN não definido")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&professional.Professional{}, &domain.Booking{}, &domain.Availability{}))
+	require.NoError(t, repository.Migrate(db))
+
+	pro := &professional.Professional{
+		Name:       "Concorrência",
+		Email:      fmt.Sprintf("concurrency-%d@example.com", time.Now().UnixNano()),
+		Password:   "x",
+		Profession: "teste",
+	}
+	require.NoError(t, db.Create(pro).Error)
+	t.Cleanup(func() {
+		db.Where("professional_id = ?", pro.ID).Delete(&domain.Booking{})
+		db.Where("professional_id = ?", pro.ID).Delete(&domain.Availability{})
+		db.Delete(pro)
+	})
+
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		require.NoError(t, db.Create(&domain.Availability{
+			ProfessionalID: pro.ID,
+			Weekday:        d,
+			StartMinute:    0,
+			EndMinute:      24 * 60,
+		}).Error)
+	}
+
+	bookingService := service.NewBookingService(repository.NewBookingRepository(db), nil, nil, nil, nil)
+
+	tomorrow := time.Now().AddDate(0, 0, 1)
+	start := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 10, 0, 0, 0, time.Local)
+
+	const workers = 20
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		created   int
+		conflicts int
+		others    []error
+	)
+
+	ready := make(chan struct{})
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(clientID uint) {
+			defer wg.Done()
+			<-ready
+
+			// Horários diferentes mas sobrepostos também devem conflitar
+			offset := time.Duration(clientID%3) * 15 * time.Minute
+			_, err := bookingService.CreateBooking(context.Background(), &service.CreateBookingRequest{
+				ProfessionalID: pro.ID,
+				ClientID:       clientID,
+				StartTime:      start.Add(offset),
+				EndTime:        start.Add(offset + time.Hour),
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch err {
+			case nil:
+				created++
+			case domain.ErrTimeSlotUnavailable:
+				conflicts++
+			default:
+				others = append(others, err)
+			}
+		}(uint(i + 1))
+	}
+	close(ready)
+	wg.Wait()
+
+	assert.Empty(t, others)
+	assert.Equal(t, 1, created)
+	assert.Equal(t, workers-1, conflicts)
+
+	var active int64
+	db.Model(&domain.Booking{}).
+		Where("professional_id = ? AND status IN ?", pro.ID, domain.ActiveStatuses).
+		Count(&active)
+	assert.Equal(t, int64(1), active)
+}
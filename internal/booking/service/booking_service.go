@@ -3,8 +3,9 @@ package service
 import (
 	"1mao/internal/booking/domain"
 	"1mao/internal/booking/repository"
+	"1mao/pkg/email"
+	"1mao/pkg/geo"
 	"context"
-	"errors"
 	"time"
 )
 
@@ -12,18 +13,58 @@ import (
 type BookingService interface {
 	CreateBooking(ctx context.Context, req *CreateBookingRequest) (*BookingResponse, error)
 	GetBooking(ctx context.Context, id uint) (*BookingResponse, error)
-	ListProfessionalBookings(ctx context.Context, professionalID uint, filters *BookingFilters) ([]*BookingResponse, error)
-	ListClientBookings(ctx context.Context, clientID uint, filters *BookingFilters) ([]*BookingResponse, error)
-		UpdateBookingStatus(ctx context.Context, id uint, status domain.BookingStatus) (*BookingResponse, error)
-	CancelBooking(ctx context.Context, id uint) error
+	ListProfessionalBookings(ctx context.Context, professionalID uint, filters *BookingFilters) (*BookingPage, error)
+	ListClientBookings(ctx context.Context, clientID uint, filters *BookingFilters) (*BookingPage, error)
+		UpdateBookingStatus(ctx context.Context, id uint, status domain.BookingStatus, reason string) (*BookingResponse, error)
+	CancelBooking(ctx context.Context, id uint, scope domain.CancelScope, reason string) (*CancellationResponse, error)
+	GetBookingHistory(ctx context.Context, id uint) (*BookingHistoryResponse, error)
+	RescheduleBooking(ctx context.Context, id uint, newStart, newEnd time.Time) (*BookingResponse, error)
+	CreateRecurringBooking(ctx context.Context, req *CreateRecurringBookingRequest) (*SeriesResponse, error)
+
+	ListAvailability(ctx context.Context, professionalID uint) ([]*AvailabilityResponse, error)
+	CreateAvailability(ctx context.Context, professionalID uint, req *AvailabilityRequest) (*AvailabilityResponse, error)
+	UpdateAvailability(ctx context.Context, professionalID, id uint, req *AvailabilityRequest) (*AvailabilityResponse, error)
+	DeleteAvailability(ctx context.Context, professionalID, id uint) error
+	ListAvailableSlots(ctx context.Context, professionalID uint, from, to time.Time, duration time.Duration) ([]*SlotResponse, error)
+
+	GetCancellationPolicy(ctx context.Context, professionalID uint) (*domain.CancellationPolicy, error)
+	UpdateCancellationPolicy(ctx context.Context, professionalID uint, req *CancellationPolicyRequest) (*domain.CancellationPolicy, error)
+
+	ExpirePendingBookings(ctx context.Context, ttl time.Duration) (int, error)
+
+	ListTimeOff(ctx context.Context, professionalID uint, from, to time.Time) ([]*domain.TimeOff, error)
+	CreateTimeOff(ctx context.Context, professionalID uint, req *TimeOffRequest) (*TimeOffResponse, error)
+	DeleteTimeOff(ctx context.Context, professionalID, id uint) error
+
+	RotateCalendarToken(ctx context.Context, professionalID uint) (string, error)
+	ProfessionalCalendar(ctx context.Context, professionalID uint, token string) ([]byte, error)
+	BookingCalendar(ctx context.Context, id uint) ([]byte, error)
+
+	JoinWaitlist(ctx context.Context, clientID uint, req *WaitlistRequest) (*domain.WaitlistEntry, error)
+	ListWaitlist(ctx context.Context, clientID uint) ([]*domain.WaitlistEntry, error)
+	LeaveWaitlist(ctx context.Context, clientID, id uint) error
+	AcceptWaitlistOffer(ctx context.Context, clientID, id uint) (*BookingResponse, error)
+	ExpireWaitlistHolds(ctx context.Context) (int, error)
+
+	CreateReview(ctx context.Context, clientID, bookingID uint, req *ReviewRequest) (*domain.Review, error)
+	ReplyToReview(ctx context.Context, professionalID, reviewID uint, reply string) (*domain.Review, error)
+	ListReviews(ctx context.Context, professionalID uint, cursor string, limit int) (*ReviewPage, error)
 }
 
 type bookingService struct {
-	bookingRepo repository.BookingRepository
+	bookingRepo   repository.BookingRepository
+	payments      PaymentSettler
+	notifier      Notifier
+	mailer        email.Mailer
+	professionals ProfessionalCache
 }
 
-func NewBookingService(bookingRepo repository.BookingRepository) BookingService {
-	return &bookingService{bookingRepo: bookingRepo}
+// NewBookingService cria o serviço de agendamentos. payments, notifier,
+// mailer e professionals podem ser nil: cancelamentos calculam a multa mas
+// não acertam pagamentos, nenhuma notificação ou e-mail é enviado e o perfil
+// em cache do profissional só é atualizado quando expirar.
+func NewBookingService(bookingRepo repository.BookingRepository, payments PaymentSettler, notifier Notifier, mailer email.Mailer, professionals ProfessionalCache) BookingService {
+	return &bookingService{bookingRepo: bookingRepo, payments: payments, notifier: notifier, mailer: mailer, professionals: professionals}
 }
 
 // DTOs
@@ -32,8 +73,11 @@ func NewBookingService(bookingRepo repository.BookingRepository) BookingService
 type CreateBookingRequest struct {
 	ProfessionalID uint      `json:"professional_id"`
 	ClientID       uint      `json:"client_id"`
+	ServiceID      uint      `json:"service_id"` // Quando informado, define a duração e o preço
 	StartTime      time.Time `json:"start_time"`
 	EndTime        time.Time `json:"end_time"`
+	// Endereço do atendimento; obrigatório quando o profissional limita a área atendida
+	ServiceAddress *geo.Address `json:"service_address,omitempty"`
 }
 
 // BookingResponse define a resposta de agendamento
@@ -45,34 +89,65 @@ type BookingResponse struct {
 	StartTime      time.Time            `json:"start_time"`
 	EndTime        time.Time            `json:"end_time"`
 	Status         domain.BookingStatus `json:"status"`
+	ServiceID      *uint                `json:"service_id,omitempty"`
+	SeriesID       *uint                `json:"series_id,omitempty"`
+	PriceCents     int64                `json:"price_cents"`
+	Currency       string               `json:"currency,omitempty"`
+	TimeZone       string               `json:"time_zone,omitempty"` // Fuso em que os horários estão expressos
+	ServiceAddress *geo.Address         `json:"service_address,omitempty"`
 	CreatedAt      time.Time            `json:"created_at"`
 	UpdatedAt      time.Time            `json:"updated_at"`
 }
 
+// BookingHistoryResponse define a linha do tempo de um agendamento
+// @Model BookingHistoryResponse
+type BookingHistoryResponse struct {
+	BookingID   uint                     `json:"booking_id"`
+	Events      []*domain.BookingEvent   `json:"events"`
+	Reschedules []*domain.BookingHistory `json:"reschedules"`
+}
+
 // BookingFilters define o payload para atualização de status
 // @Model BookingFilters
 type BookingFilters struct {
 	From   time.Time
 	To     time.Time
 	Status domain.BookingStatus
+	// Fuso IANA de exibição; vazio usa o do usuário
+	TimeZone string
+	// Ordenação; vazio usa a padrão da listagem
+	Sort domain.BookingSort
+	// Token next_cursor da página anterior
+	Cursor string
+	// Tamanho da página; zero usa domain.DefaultPageSize
+	Limit int
 }
 
+// BookingPage é uma página da listagem de agendamentos
+// @Model BookingPage
+type BookingPage struct {
+	Bookings []*BookingResponse `json:"bookings"`
+	// Vazio quando não há mais páginas
+	NextCursor string `json:"next_cursor,omitempty"`
+}
 
-func (s *bookingService) CreateBooking(ctx context.Context, req *CreateBookingRequest) (*BookingResponse, error) {
 
-	// validação basica
-	if req.StartTime.Before(time.Now()) {
-		return nil, errors.New("impossivel agendar no passado")
+func (s *bookingService) CreateBooking(ctx context.Context, req *CreateBookingRequest) (*BookingResponse, error) {
+	create, err := s.prepareBooking(ctx, req)
+	if err != nil {
+		return nil, err
 	}
-	if req.EndTime.Before(req.StartTime) {
-		return nil, errors.New("o fim do agendamento precisa ser depois do inicio")
+
+	// Verifica horário de atendimento do profissional, no fuso dele
+	if err := s.checkWorkingHours(ctx, create.ProfessionalID, create.StartTime, create.EndTime); err != nil {
+		return nil, err
 	}
 	// Verifica disponibilidade
 	available, err := s.bookingRepo.IsTimeSlotAvailable(
 		ctx,
-		req.ProfessionalID,
-		req.StartTime,
-		req.EndTime,
+		create.ProfessionalID,
+		create.StartTime,
+		create.EndTime,
 	)
 	if err != nil {
 		return nil, err
@@ -82,104 +157,317 @@ func (s *bookingService) CreateBooking(ctx context.Context, req *CreateBookingRe
 	}
 
 	// adicionar no repositorio
-	booking, err := s.bookingRepo.Create(ctx, &repository.CreateBookingRequest{
+	booking, err := s.bookingRepo.Create(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	return s.toResponse(booking), nil
+
+}
+
+// prepareBooking resolve o serviço do catálogo e faz as validações básicas
+func (s *bookingService) prepareBooking(ctx context.Context, req *CreateBookingRequest) (*repository.CreateBookingRequest, error) {
+	create := &repository.CreateBookingRequest{
 		ProfessionalID: req.ProfessionalID,
 		ClientID:       req.ClientID,
 		StartTime:      req.StartTime,
 		EndTime:        req.EndTime,
-	})
-	if err != nil {
+	}
+
+	if err := s.requireVerifiedEmail(ctx, req.ClientID); err != nil {
 		return nil, err
 	}
-	return s.toResponse(booking), nil
 
+	// Serviço do catálogo define o fim e o preço do agendamento
+	if req.ServiceID != 0 {
+		offering, err := s.bookingRepo.GetServiceOffering(ctx, req.ServiceID)
+		if err != nil {
+			return nil, err
+		}
+		if offering.ProfessionalID != req.ProfessionalID || !offering.Active {
+			return nil, domain.ErrServiceUnavailable
+		}
+		serviceID := offering.ID
+		create.ServiceID = &serviceID
+		create.EndTime = req.StartTime.Add(offering.Duration())
+		create.PriceCents = offering.PriceCents
+		create.Currency = offering.Currency
+	}
+
+	// validação basica
+	if create.StartTime.Before(time.Now()) {
+		return nil, domain.ErrStartInPast
+	}
+	if !create.EndTime.After(create.StartTime) {
+		return nil, domain.ErrInvalidTimeRange
+	}
+
+	address, err := s.checkServiceArea(ctx, create.ProfessionalID, req.ServiceAddress)
+	if err != nil {
+		return nil, err
+	}
+	create.ServiceAddress = address
+	return create, nil
 }
 
+// GetBooking retorna o agendamento se o autor da operação participar dele
 func (s *bookingService) GetBooking(ctx context.Context, id uint) (*BookingResponse, error) {
-	bookings, err := s.bookingRepo.GetByID(ctx, id)
+	bookings, err := s.authorizedBooking(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 	return s.toResponse(bookings), nil
 }
 
-func (s *bookingService) ListProfessionalBookings(ctx context.Context, professionalID uint, filters *BookingFilters) ([]*BookingResponse, error) {
-	var from, to time.Time
-	if filters != nil {
-		from = filters.From
-		to = filters.To
+func (s *bookingService) ListProfessionalBookings(ctx context.Context, professionalID uint, filters *BookingFilters) (*BookingPage, error) {
+	if filters == nil {
+		filters = &BookingFilters{}
 	}
 
-	bookings, err := s.bookingRepo.ListByProfessional(ctx, professionalID, from, to)
+	loc, err := viewerLocation(ctx, filters.TimeZone, s.bookingRepo.ProfessionalTimeZone, professionalID)
 	if err != nil {
-		return nil, err // Propagação correta do erro
+		return nil, err
 	}
 
-	var filtered []*domain.Booking
-	if filters != nil && filters.Status != "" {
-		for _, b := range bookings {
-			if b.Status == filters.Status {
-				filtered = append(filtered, b)
-			}
-		}
-	} else {
-		filtered = bookings
+	// Agenda do profissional: próximos compromissos primeiro
+	return s.listBookings(ctx, &repository.BookingListQuery{ProfessionalID: professionalID}, filters, domain.SortStartAsc, loc)
+}
+
+func (s *bookingService) ListClientBookings(ctx context.Context, clientID uint, filters *BookingFilters) (*BookingPage, error) {
+	if filters == nil {
+		filters = &BookingFilters{}
+	}
+
+	loc, err := viewerLocation(ctx, filters.TimeZone, s.bookingRepo.ClientTimeZone, clientID)
+	if err != nil {
+		return nil, err
 	}
 
-	return s.toListResponse(filtered), nil
+	// Histórico do cliente: mais recentes primeiro
+	return s.listBookings(ctx, &repository.BookingListQuery{ClientID: clientID}, filters, domain.SortStartDesc, loc)
 }
 
-func (s *bookingService) ListClientBookings(ctx context.Context, clientID uint, filters *BookingFilters) ([]*BookingResponse, error) {
-    var from, to time.Time
-    if filters != nil {
-        from = filters.From
-        to = filters.To
-    }
+// listBookings completa a consulta com os filtros, busca uma linha a mais que
+// o limite para saber se há próxima página e monta o cursor a partir da
+// última linha entregue
+func (s *bookingService) listBookings(ctx context.Context, query *repository.BookingListQuery, filters *BookingFilters, defaultSort domain.BookingSort, loc *time.Location) (*BookingPage, error) {
+	sort := filters.Sort
+	if sort == "" {
+		sort = defaultSort
+	}
+	if !sort.Valid() {
+		return nil, domain.ErrInvalidListQuery
+	}
 
-    bookings, err := s.bookingRepo.ListByClient(ctx, clientID, from, to)
-    if err != nil {
-        return nil, err
-    }
+	limit := filters.Limit
+	if limit == 0 {
+		limit = domain.DefaultPageSize
+	}
+	if limit < 0 || limit > domain.MaxPageSize {
+		return nil, domain.ErrInvalidListQuery
+	}
 
-    var filtered []*domain.Booking
-    if filters != nil && filters.Status != "" {
-        for _, b := range bookings {
-            if b.Status == filters.Status {
-                filtered = append(filtered, b)
-            }
-        }
-    } else {
-        filtered = bookings
-    }
+	if filters.Cursor != "" {
+		cursor, err := domain.DecodeBookingCursor(filters.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		// O cursor só vale para a ordenação em que foi gerado
+		if cursor.Sort != sort {
+			return nil, domain.ErrInvalidListQuery
+		}
+		query.After = cursor
+	}
 
-    return s.toListResponse(filtered), nil
+	query.From = filters.From
+	query.To = filters.To
+	query.Status = filters.Status
+	query.Sort = sort
+	query.Limit = limit + 1
+
+	bookings, err := s.bookingRepo.ListPage(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &BookingPage{}
+	if len(bookings) > limit {
+		bookings = bookings[:limit]
+		page.NextCursor = domain.CursorAfter(sort, bookings[len(bookings)-1]).Encode()
+	}
+	page.Bookings = s.toListResponseIn(bookings, loc)
+	if page.Bookings == nil {
+		page.Bookings = []*BookingResponse{}
+	}
+	return page, nil
 }
 
-func (s *bookingService) UpdateBookingStatus(ctx context.Context, id uint, status domain.BookingStatus) (*BookingResponse, error) {
-	booking, err := s.bookingRepo.UpdateStatus(ctx, id, status)
+// UpdateBookingStatus aplica a transição de status validando a máquina de
+// estados e as restrições de horário (domain.Booking.ValidateTransition). O
+// cancelamento passa pela mesma política e acerto de pagamento de
+// CancelBooking; ao marcar não comparecimento, a multa da política do
+// profissional é cobrada. Só o profissional dono muda o status; o cliente
+// pode apenas cancelar.
+func (s *bookingService) UpdateBookingStatus(ctx context.Context, id uint, status domain.BookingStatus, reason string) (*BookingResponse, error) {
+	current, err := s.bookingRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if err := authorizeStatus(ctx, current, status); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if err := current.ValidateTransition(status, now); err != nil {
+		return nil, err
+	}
+
+	booking, err := s.bookingRepo.UpdateStatus(ctx, id, status, reason)
+	if err != nil {
+		return nil, err
+	}
+	switch status {
+	case domain.StatusCancelled:
+		s.offerFreedSlots(ctx, []*domain.Booking{booking})
+		if _, err := s.settleCancellation(ctx, []*domain.Booking{booking}, now); err != nil {
+			return nil, err
+		}
+	case domain.StatusConfirmed:
+		s.sendConfirmationEmail(ctx, booking)
+	case domain.StatusNoShow:
+		s.chargeNoShow(ctx, booking)
+	}
 	return s.toResponse(booking), nil
 }
 
-func (s *bookingService) CancelBooking(ctx context.Context, id uint) error {
-	_, err := s.bookingRepo.UpdateStatus(ctx, id, domain.StatusCancelled)
-	return err
+// CancelBooking cancela o agendamento (ou as ocorrências futuras da série),
+// aplica a política de cancelamento do profissional e acerta o pagamento.
+// Cliente e profissional do agendamento podem cancelar.
+func (s *bookingService) CancelBooking(ctx context.Context, id uint, scope domain.CancelScope, reason string) (*CancellationResponse, error) {
+	booking, err := s.bookingRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeStatus(ctx, booking, domain.StatusCancelled); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if scope == domain.CancelSeries {
+		if booking.SeriesID == nil {
+			return nil, domain.ErrBookingNotInSeries
+		}
+		cancelled, err := s.bookingRepo.CancelSeries(ctx, *booking.SeriesID, now, reason)
+		if err != nil {
+			return nil, err
+		}
+		s.offerFreedSlots(ctx, cancelled)
+		return s.settleCancellation(ctx, cancelled, now)
+	}
+
+	cancelled, err := s.bookingRepo.UpdateStatus(ctx, id, domain.StatusCancelled, reason)
+	if err != nil {
+		return nil, err
+	}
+	s.offerFreedSlots(ctx, []*domain.Booking{cancelled})
+	return s.settleCancellation(ctx, []*domain.Booking{cancelled}, now)
+}
+
+// RescheduleBooking move o agendamento para um novo horário. Se newEnd for
+// zero, a duração original é mantida. O autor da remarcação vem do contexto
+// (domain.WithActor) e precisa participar do agendamento.
+func (s *bookingService) RescheduleBooking(ctx context.Context, id uint, newStart, newEnd time.Time) (*BookingResponse, error) {
+	booking, err := s.authorizedBooking(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !booking.IsReschedulable() {
+		return nil, domain.ErrBookingNotActive
+	}
+
+	if newEnd.IsZero() {
+		newEnd = newStart.Add(booking.EndTime.Sub(booking.StartTime))
+	}
+	if newStart.Before(time.Now()) {
+		return nil, domain.ErrStartInPast
+	}
+	if !newEnd.After(newStart) {
+		return nil, domain.ErrInvalidTimeRange
+	}
+
+	if err := s.checkWorkingHours(ctx, booking.ProfessionalID, newStart, newEnd); err != nil {
+		return nil, err
+	}
+
+	rescheduled, err := s.bookingRepo.Reschedule(ctx, id, newStart, newEnd, domain.ActorFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return s.toResponse(rescheduled), nil
+}
+
+// GetBookingHistory retorna as mudanças de status e remarcações do agendamento
+func (s *bookingService) GetBookingHistory(ctx context.Context, id uint) (*BookingHistoryResponse, error) {
+	if _, err := s.authorizedBooking(ctx, id); err != nil {
+		return nil, err
+	}
+
+	events, err := s.bookingRepo.ListEvents(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	reschedules, err := s.bookingRepo.ListHistory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BookingHistoryResponse{
+		BookingID:   id,
+		Events:      events,
+		Reschedules: reschedules,
+	}
+	if response.Events == nil {
+		response.Events = []*domain.BookingEvent{}
+	}
+	if response.Reschedules == nil {
+		response.Reschedules = []*domain.BookingHistory{}
+	}
+	return response, nil
 }
 
 // Helpers
 func (s *bookingService) toResponse(booking *domain.Booking) *BookingResponse {
-	return &BookingResponse{
+	response := &BookingResponse{
 		ID:             booking.ID,
 		ProfessionalID: booking.ProfessionalID,
 		ClientID:       booking.ClientID,
 		StartTime:      booking.StartTime,
 		EndTime:        booking.EndTime,
 		Status:         booking.Status,
+		ServiceID:      booking.ServiceID,
+		SeriesID:       booking.SeriesID,
+		PriceCents:     booking.PriceCents,
+		Currency:       booking.Currency,
 		CreatedAt:      booking.CreatedAt,
 		UpdatedAt:      booking.UpdatedAt,
 	}
+	if !booking.ServiceAddress.IsZero() {
+		address := booking.ServiceAddress
+		response.ServiceAddress = &address
+	}
+	return response
+}
+
+// toListResponseIn converte os agendamentos expressando os horários em loc
+func (s *bookingService) toListResponseIn(bookings []*domain.Booking, loc *time.Location) []*BookingResponse {
+	response := s.toListResponse(bookings)
+	for _, b := range response {
+		b.StartTime = b.StartTime.In(loc)
+		b.EndTime = b.EndTime.In(loc)
+		b.CreatedAt = b.CreatedAt.In(loc)
+		b.UpdatedAt = b.UpdatedAt.In(loc)
+		b.TimeZone = loc.String()
+	}
+	return response
 }
 
 func (s *bookingService) toListResponse(bookings []*domain.Booking) []*BookingResponse {
@@ -8,8 +8,15 @@ import (
 	"1mao/internal/booking/domain"
 	"1mao/internal/booking/repository"
 	"1mao/internal/booking/service"
+	notification "1mao/internal/notification/domain"
+	payment "1mao/internal/payment/domain"
+	professional "1mao/internal/professional/domain"
+	"1mao/pkg/email"
+	"1mao/pkg/geo"
+	"1mao/pkg/timezone"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 type MockBookingRepository struct {
@@ -48,8 +55,8 @@ func (m *MockBookingRepository) ListByClient(ctx context.Context, clientID uint,
 	return args.Get(0).([]*domain.Booking), args.Error(1)
 }
 
-func (m *MockBookingRepository) UpdateStatus(ctx context.Context, id uint, status domain.BookingStatus) (*domain.Booking, error) {
-	args := m.Called(ctx, id, status)
+func (m *MockBookingRepository) UpdateStatus(ctx context.Context, id uint, status domain.BookingStatus, reason string) (*domain.Booking, error) {
+	args := m.Called(ctx, id, status, reason)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -61,10 +68,330 @@ func (m *MockBookingRepository) IsTimeSlotAvailable(ctx context.Context, profess
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockBookingRepository) ListAvailability(ctx context.Context, professionalID uint) ([]*domain.Availability, error) {
+	args := m.Called(ctx, professionalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Availability), args.Error(1)
+}
+
+func (m *MockBookingRepository) GetAvailability(ctx context.Context, id uint) (*domain.Availability, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Availability), args.Error(1)
+}
+
+func (m *MockBookingRepository) CreateAvailability(ctx context.Context, availability *domain.Availability) error {
+	args := m.Called(ctx, availability)
+	return args.Error(0)
+}
+
+func (m *MockBookingRepository) UpdateAvailability(ctx context.Context, availability *domain.Availability) error {
+	args := m.Called(ctx, availability)
+	return args.Error(0)
+}
+
+func (m *MockBookingRepository) DeleteAvailability(ctx context.Context, id uint) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockBookingRepository) GetServiceOffering(ctx context.Context, id uint) (*professional.ServiceOffering, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*professional.ServiceOffering), args.Error(1)
+}
+
+func (m *MockBookingRepository) CreateSeries(ctx context.Context, series *domain.BookingSeries, occurrences []*repository.CreateBookingRequest) ([]*domain.Booking, error) {
+	args := m.Called(ctx, series, occurrences)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Booking), args.Error(1)
+}
+
+func (m *MockBookingRepository) CancelSeries(ctx context.Context, seriesID uint, from time.Time, reason string) ([]*domain.Booking, error) {
+	args := m.Called(ctx, seriesID, from, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Booking), args.Error(1)
+}
+
+func (m *MockBookingRepository) Reschedule(ctx context.Context, id uint, start, end time.Time, actor domain.Actor) (*domain.Booking, error) {
+	args := m.Called(ctx, id, start, end, actor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Booking), args.Error(1)
+}
+
+func (m *MockBookingRepository) ListEvents(ctx context.Context, bookingID uint) ([]*domain.BookingEvent, error) {
+	args := m.Called(ctx, bookingID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.BookingEvent), args.Error(1)
+}
+
+func (m *MockBookingRepository) ListHistory(ctx context.Context, bookingID uint) ([]*domain.BookingHistory, error) {
+	args := m.Called(ctx, bookingID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.BookingHistory), args.Error(1)
+}
+
+func (m *MockBookingRepository) GetCancellationPolicy(ctx context.Context, professionalID uint) (*domain.CancellationPolicy, error) {
+	args := m.Called(ctx, professionalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CancellationPolicy), args.Error(1)
+}
+
+func (m *MockBookingRepository) SaveCancellationPolicy(ctx context.Context, policy *domain.CancellationPolicy) error {
+	args := m.Called(ctx, policy)
+	return args.Error(0)
+}
+
+func (m *MockBookingRepository) ExpirePending(ctx context.Context, createdBefore time.Time) ([]*domain.Booking, error) {
+	args := m.Called(ctx, createdBefore)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Booking), args.Error(1)
+}
+
+func (m *MockBookingRepository) ListTimeOff(ctx context.Context, professionalID uint, from, to time.Time) ([]*domain.TimeOff, error) {
+	args := m.Called(ctx, professionalID, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TimeOff), args.Error(1)
+}
+
+func (m *MockBookingRepository) GetTimeOff(ctx context.Context, id uint) (*domain.TimeOff, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TimeOff), args.Error(1)
+}
+
+func (m *MockBookingRepository) CreateTimeOff(ctx context.Context, timeOff *domain.TimeOff, force bool) ([]*domain.Booking, error) {
+	args := m.Called(ctx, timeOff, force)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Booking), args.Error(1)
+}
+
+func (m *MockBookingRepository) DeleteTimeOff(ctx context.Context, id uint) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockBookingRepository) GetCalendarToken(ctx context.Context, professionalID uint) (*domain.CalendarToken, error) {
+	args := m.Called(ctx, professionalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CalendarToken), args.Error(1)
+}
+
+func (m *MockBookingRepository) SaveCalendarToken(ctx context.Context, token *domain.CalendarToken) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+func (m *MockBookingRepository) ClientNames(ctx context.Context, ids []uint) (map[uint]string, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uint]string), args.Error(1)
+}
+
+func (m *MockBookingRepository) ProfessionalNames(ctx context.Context, ids []uint) (map[uint]string, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uint]string), args.Error(1)
+}
+
+func (m *MockBookingRepository) ProfessionalTimeZone(ctx context.Context, professionalID uint) (string, error) {
+	args := m.Called(ctx, professionalID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockBookingRepository) ClientTimeZone(ctx context.Context, clientID uint) (string, error) {
+	args := m.Called(ctx, clientID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockBookingRepository) ListPage(ctx context.Context, query *repository.BookingListQuery) ([]*domain.Booking, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Booking), args.Error(1)
+}
+
+func (m *MockBookingRepository) CreateWaitlistEntry(ctx context.Context, entry *domain.WaitlistEntry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+func (m *MockBookingRepository) GetWaitlistEntry(ctx context.Context, id uint) (*domain.WaitlistEntry, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WaitlistEntry), args.Error(1)
+}
+
+func (m *MockBookingRepository) ListWaitlistByClient(ctx context.Context, clientID uint) ([]*domain.WaitlistEntry, error) {
+	args := m.Called(ctx, clientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.WaitlistEntry), args.Error(1)
+}
+
+func (m *MockBookingRepository) CancelWaitlistEntry(ctx context.Context, id uint) (*domain.WaitlistEntry, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WaitlistEntry), args.Error(1)
+}
+
+func (m *MockBookingRepository) OfferWaitlistSlot(ctx context.Context, offer domain.WaitlistOffer, holdUntil time.Time) (*domain.WaitlistEntry, error) {
+	args := m.Called(ctx, offer, holdUntil)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WaitlistEntry), args.Error(1)
+}
+
+func (m *MockBookingRepository) ExpireWaitlistHolds(ctx context.Context, now time.Time) ([]*domain.WaitlistEntry, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.WaitlistEntry), args.Error(1)
+}
+
+func (m *MockBookingRepository) AcceptWaitlistOffer(ctx context.Context, id uint, now time.Time) (*domain.Booking, error) {
+	args := m.Called(ctx, id, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Booking), args.Error(1)
+}
+
+func (m *MockBookingRepository) CreateReview(ctx context.Context, review *domain.Review) error {
+	args := m.Called(ctx, review)
+	return args.Error(0)
+}
+
+func (m *MockBookingRepository) GetReview(ctx context.Context, id uint) (*domain.Review, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Review), args.Error(1)
+}
+
+func (m *MockBookingRepository) ReplyToReview(ctx context.Context, id uint, reply string) (*domain.Review, error) {
+	args := m.Called(ctx, id, reply)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Review), args.Error(1)
+}
+
+func (m *MockBookingRepository) ListReviews(ctx context.Context, professionalID uint, after *domain.ReviewCursor, limit int) ([]*domain.Review, error) {
+	args := m.Called(ctx, professionalID, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Review), args.Error(1)
+}
+
+func (m *MockBookingRepository) ProfessionalServiceArea(ctx context.Context, professionalID uint) (*geo.ServiceArea, error) {
+	args := m.Called(ctx, professionalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*geo.ServiceArea), args.Error(1)
+}
+func (m *MockBookingRepository) EmailVerified(ctx context.Context, role string, id uint) (bool, error) {
+	args := m.Called(ctx, role, id)
+	return args.Bool(0), args.Error(1)
+}
+func (m *MockBookingRepository) ClientContact(ctx context.Context, clientID uint) (*domain.Contact, error) {
+	args := m.Called(ctx, clientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Contact), args.Error(1)
+}
+
+type MockProfessionalCache struct {
+	mock.Mock
+}
+
+func (m *MockProfessionalCache) InvalidateProfessional(id uint) {
+	m.Called(id)
+}
+
+type MockPaymentSettler struct {
+	mock.Mock
+}
+
+func (m *MockPaymentSettler) SettleCancellation(clientID string, bookingID string, fee int64) (*payment.Transaction, error) {
+	args := m.Called(clientID, bookingID, fee)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*payment.Transaction), args.Error(1)
+}
+
+type MockNotifier struct {
+	mock.Mock
+}
+
+func (m *MockNotifier) SendNotification(n notification.Notification) {
+	m.Called(n)
+}
+
+// fullWeek devolve janelas cobrindo o dia inteiro, todos os dias
+func fullWeek(professionalID uint) []*domain.Availability {
+	var windows []*domain.Availability
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		windows = append(windows, &domain.Availability{
+			ProfessionalID: professionalID,
+			Weekday:        d,
+			StartMinute:    0,
+			EndMinute:      24 * 60,
+		})
+	}
+	return windows
+}
+
 func TestBookingService_ListClientBookings(t *testing.T) {
     ctx := context.Background()
     mockRepo := new(MockBookingRepository)
-    bookingService := service.NewBookingService(mockRepo)
+    bookingService := service.NewBookingService(mockRepo, nil, nil, nil, nil)
+    mockRepo.On("ClientTimeZone", ctx, uint(1)).Return("America/Sao_Paulo", nil).Maybe()
 
     // Mock data
     mockBookings := []*domain.Booking{
@@ -73,14 +400,16 @@ func TestBookingService_ListClientBookings(t *testing.T) {
     }
 
     t.Run("Success - all bookings", func(t *testing.T) {
-        mockRepo.On("ListByClient", ctx, uint(1), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
-            Return(mockBookings, nil).Once()
+        mockRepo.On("ListPage", ctx, mock.MatchedBy(func(q *repository.BookingListQuery) bool {
+            return q.ClientID == 1 && q.Sort == domain.SortStartDesc && q.Limit == domain.DefaultPageSize+1 && q.After == nil
+        })).Return(mockBookings, nil).Once()
 
         // Chamada com filtros vazios
         result, err := bookingService.ListClientBookings(ctx, 1, &service.BookingFilters{})
         
         assert.NoError(t, err)
-        assert.Equal(t, 2, len(result))
+        assert.Equal(t, 2, len(result.Bookings))
+        assert.Empty(t, result.NextCursor)
         mockRepo.AssertExpectations(t)
     })
 
@@ -88,8 +417,9 @@ func TestBookingService_ListClientBookings(t *testing.T) {
         from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
         to := time.Date(2025, 12, 31, 23, 59, 59, 0, time.UTC)
         
-        mockRepo.On("ListByClient", ctx, uint(1), from, to).
-            Return(mockBookings, nil).Once()
+        mockRepo.On("ListPage", ctx, mock.MatchedBy(func(q *repository.BookingListQuery) bool {
+            return q.From.Equal(from) && q.To.Equal(to)
+        })).Return(mockBookings, nil).Once()
 
         // Chamada com filtros de data
         result, err := bookingService.ListClientBookings(ctx, 1, &service.BookingFilters{
@@ -98,13 +428,14 @@ func TestBookingService_ListClientBookings(t *testing.T) {
         })
         
         assert.NoError(t, err)
-        assert.Equal(t, 2, len(result))
+        assert.Equal(t, 2, len(result.Bookings))
         mockRepo.AssertExpectations(t)
     })
 
-    t.Run("Success - with status filter", func(t *testing.T) {
-        mockRepo.On("ListByClient", ctx, uint(1), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
-            Return(mockBookings, nil).Once()
+    t.Run("Success - status filtered by repository", func(t *testing.T) {
+        mockRepo.On("ListPage", ctx, mock.MatchedBy(func(q *repository.BookingListQuery) bool {
+            return q.Status == domain.StatusConfirmed
+        })).Return(mockBookings[:1], nil).Once()
 
         // Chamada com filtro de status
         result, err := bookingService.ListClientBookings(ctx, 1, &service.BookingFilters{
@@ -112,13 +443,56 @@ func TestBookingService_ListClientBookings(t *testing.T) {
         })
         
         assert.NoError(t, err)
-        assert.Equal(t, 1, len(result))
-        assert.Equal(t, domain.StatusConfirmed, result[0].Status)
+        assert.Equal(t, 1, len(result.Bookings))
+        assert.Equal(t, domain.StatusConfirmed, result.Bookings[0].Status)
+        mockRepo.AssertExpectations(t)
+    })
+
+    t.Run("Success - next cursor continues after last row", func(t *testing.T) {
+        start := time.Date(2025, 3, 10, 13, 0, 0, 0, time.UTC)
+        rows := []*domain.Booking{
+            {ID: 7, StartTime: start.Add(time.Hour)},
+            {ID: 5, StartTime: start},
+            {ID: 4, StartTime: start.Add(-time.Hour)},
+        }
+        mockRepo.On("ListPage", ctx, mock.MatchedBy(func(q *repository.BookingListQuery) bool {
+            return q.Limit == 3 && q.After == nil
+        })).Return(rows, nil).Once()
+
+        first, err := bookingService.ListClientBookings(ctx, 1, &service.BookingFilters{Limit: 2})
+        require.NoError(t, err)
+        require.Len(t, first.Bookings, 2)
+        require.NotEmpty(t, first.NextCursor)
+
+        mockRepo.On("ListPage", ctx, mock.MatchedBy(func(q *repository.BookingListQuery) bool {
+            return q.After != nil && q.After.ID == 5 && q.After.Key.Equal(start)
+        })).Return(rows[2:], nil).Once()
+
+        second, err := bookingService.ListClientBookings(ctx, 1, &service.BookingFilters{Limit: 2, Cursor: first.NextCursor})
+        require.NoError(t, err)
+        assert.Len(t, second.Bookings, 1)
+        assert.Empty(t, second.NextCursor)
         mockRepo.AssertExpectations(t)
     })
 
+    t.Run("Error - cursor from another sort", func(t *testing.T) {
+        cursor := domain.CursorAfter(domain.SortCreatedAsc, &domain.Booking{ID: 1}).Encode()
+
+        result, err := bookingService.ListClientBookings(ctx, 1, &service.BookingFilters{Cursor: cursor})
+
+        assert.Equal(t, domain.ErrInvalidListQuery, err)
+        assert.Nil(t, result)
+    })
+
+    t.Run("Error - limit above maximum", func(t *testing.T) {
+        result, err := bookingService.ListClientBookings(ctx, 1, &service.BookingFilters{Limit: domain.MaxPageSize + 1})
+
+        assert.Equal(t, domain.ErrInvalidListQuery, err)
+        assert.Nil(t, result)
+    })
+
     t.Run("Error - repository error", func(t *testing.T) {
-        mockRepo.On("ListByClient", ctx, uint(1), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+        mockRepo.On("ListPage", ctx, mock.Anything).
             Return(nil, assert.AnError).Once()
 
         // Chamada que deve retornar erro
@@ -129,11 +503,33 @@ func TestBookingService_ListClientBookings(t *testing.T) {
         assert.Equal(t, assert.AnError, err)
         mockRepo.AssertExpectations(t)
     })
+
+    t.Run("Success - times in requested zone", func(t *testing.T) {
+        start := time.Date(2025, 3, 10, 13, 0, 0, 0, time.UTC)
+        mockRepo.On("ListPage", ctx, mock.Anything).
+            Return([]*domain.Booking{{ID: 3, Status: domain.StatusConfirmed, StartTime: start, EndTime: start.Add(time.Hour)}}, nil).Once()
+
+        result, err := bookingService.ListClientBookings(ctx, 1, &service.BookingFilters{TimeZone: "Asia/Tokyo"})
+
+        require.NoError(t, err)
+        require.Len(t, result.Bookings, 1)
+        assert.Equal(t, "Asia/Tokyo", result.Bookings[0].TimeZone)
+        assert.Equal(t, 22, result.Bookings[0].StartTime.Hour())
+        assert.True(t, start.Equal(result.Bookings[0].StartTime))
+    })
+
+    t.Run("Error - invalid zone", func(t *testing.T) {
+        result, err := bookingService.ListClientBookings(ctx, 1, &service.BookingFilters{TimeZone: "Mars/Olympus"})
+
+        assert.ErrorIs(t, err, timezone.ErrInvalid)
+        assert.Nil(t, result)
+    })
 }
 func TestBookingService_ListProfessionalBookings(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := new(MockBookingRepository)
-	bookingService := service.NewBookingService(mockRepo)
+	bookingService := service.NewBookingService(mockRepo, nil, nil, nil, nil)
+	mockRepo.On("ProfessionalTimeZone", ctx, uint(1)).Return("America/Sao_Paulo", nil).Maybe()
 
 	// Mock data
 	mockBookings := []*domain.Booking{
@@ -142,32 +538,52 @@ func TestBookingService_ListProfessionalBookings(t *testing.T) {
 	}
 
 	t.Run("Success - all bookings", func(t *testing.T) {
-		mockRepo.On("ListByProfessional", ctx, uint(1), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
-			Return(mockBookings, nil).Once()
+		mockRepo.On("ListPage", ctx, mock.MatchedBy(func(q *repository.BookingListQuery) bool {
+			return q.ProfessionalID == 1 && q.ClientID == 0 && q.Sort == domain.SortStartAsc
+		})).Return(mockBookings, nil).Once()
 
 		result, err := bookingService.ListProfessionalBookings(ctx, 1, &service.BookingFilters{})
 		
 		assert.NoError(t, err)
-		assert.Equal(t, 2, len(result))
+		assert.Equal(t, 2, len(result.Bookings))
 		mockRepo.AssertExpectations(t)
 	})
 
-	t.Run("Success - with status filter", func(t *testing.T) {
-		mockRepo.On("ListByProfessional", ctx, uint(1), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
-			Return(mockBookings, nil).Once()
+	t.Run("Success - with status filter and sort", func(t *testing.T) {
+		mockRepo.On("ListPage", ctx, mock.MatchedBy(func(q *repository.BookingListQuery) bool {
+			return q.Status == domain.StatusConfirmed && q.Sort == domain.SortCreatedDesc
+		})).Return(mockBookings[:1], nil).Once()
 
 		result, err := bookingService.ListProfessionalBookings(ctx, 1, &service.BookingFilters{
 			Status: domain.StatusConfirmed,
+			Sort:   domain.SortCreatedDesc,
 		})
 		
 		assert.NoError(t, err)
-		assert.Equal(t, 1, len(result))
-		assert.Equal(t, domain.StatusConfirmed, result[0].Status)
+		assert.Equal(t, 1, len(result.Bookings))
+		assert.Equal(t, domain.StatusConfirmed, result.Bookings[0].Status)
 		mockRepo.AssertExpectations(t)
 	})
 
+	t.Run("Success - empty page", func(t *testing.T) {
+		mockRepo.On("ListPage", ctx, mock.Anything).Return([]*domain.Booking{}, nil).Once()
+
+		result, err := bookingService.ListProfessionalBookings(ctx, 1, nil)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result.Bookings)
+		assert.Empty(t, result.Bookings)
+	})
+
+	t.Run("Error - unknown sort", func(t *testing.T) {
+		result, err := bookingService.ListProfessionalBookings(ctx, 1, &service.BookingFilters{Sort: "price"})
+
+		assert.Equal(t, domain.ErrInvalidListQuery, err)
+		assert.Nil(t, result)
+	})
+
 	t.Run("Error - repository error", func(t *testing.T) {
-		mockRepo.On("ListByProfessional", ctx, uint(1), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+		mockRepo.On("ListPage", ctx, mock.Anything).
 			Return(nil, assert.AnError).Once()
 
 		result, err := bookingService.ListProfessionalBookings(ctx, 1, &service.BookingFilters{})
@@ -182,9 +598,13 @@ func TestBookingService_ListProfessionalBookings(t *testing.T) {
 func TestBookingService_CreateBooking(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := new(MockBookingRepository)
-	bookingService := service.NewBookingService(mockRepo)
+	bookingService := service.NewBookingService(mockRepo, nil, nil, nil, nil)
+	mockRepo.On("ProfessionalTimeZone", ctx, mock.Anything).Return("America/Sao_Paulo", nil).Maybe()
+	mockRepo.On("ProfessionalServiceArea", ctx, uint(1)).Return(&geo.ServiceArea{}, nil).Maybe()
+	mockRepo.On("EmailVerified", ctx, domain.RoleClient, uint(2)).Return(true, nil).Maybe()
 
-	futureTime := time.Now().Add(24 * time.Hour)
+	tomorrow := time.Now().AddDate(0, 0, 1)
+	futureTime := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 10, 0, 0, 0, time.Local)
 
 	t.Run("Success - create booking", func(t *testing.T) {
 		req := &service.CreateBookingRequest{
@@ -203,6 +623,7 @@ func TestBookingService_CreateBooking(t *testing.T) {
 			Status:        domain.StatusPending,
 		}
 
+		mockRepo.On("ListAvailability", ctx, req.ProfessionalID).Return(fullWeek(req.ProfessionalID), nil).Once()
 		mockRepo.On("IsTimeSlotAvailable", ctx, req.ProfessionalID, req.StartTime, req.EndTime).Return(true, nil).Once()
 		mockRepo.On("Create", ctx, mock.AnythingOfType("*repository.CreateBookingRequest")).Return(expectedBooking, nil).Once()
 
@@ -221,6 +642,7 @@ func TestBookingService_CreateBooking(t *testing.T) {
 			EndTime:       futureTime.Add(time.Hour),
 		}
 
+		mockRepo.On("ListAvailability", ctx, req.ProfessionalID).Return(fullWeek(req.ProfessionalID), nil).Once()
 		mockRepo.On("IsTimeSlotAvailable", ctx, req.ProfessionalID, req.StartTime, req.EndTime).Return(false, nil).Once()
 
 		result, err := bookingService.CreateBooking(ctx, req)
@@ -239,6 +661,7 @@ func TestBookingService_CreateBooking(t *testing.T) {
 			EndTime:       futureTime.Add(time.Hour),
 		}
 
+		mockRepo.On("ListAvailability", ctx, req.ProfessionalID).Return(fullWeek(req.ProfessionalID), nil).Once()
 		mockRepo.On("IsTimeSlotAvailable", ctx, req.ProfessionalID, req.StartTime, req.EndTime).Return(true, nil).Once()
 		mockRepo.On("Create", ctx, mock.AnythingOfType("*repository.CreateBookingRequest")).Return(nil, assert.AnError).Once()
 
@@ -249,33 +672,683 @@ func TestBookingService_CreateBooking(t *testing.T) {
 		assert.Equal(t, assert.AnError, err)
 		mockRepo.AssertExpectations(t)
 	})
-}
 
-func TestBookingService_GetBooking(t *testing.T) {
-	ctx := context.Background()
-	mockRepo := new(MockBookingRepository)
-	bookingService := service.NewBookingService(mockRepo)
-
-	t.Run("Success - get booking", func(t *testing.T) {
-		bookingID := uint(1)
-		expectedBooking := &domain.Booking{
-			ID:     bookingID,
-			Status: domain.StatusConfirmed,
+	t.Run("Success - service defines end time and price", func(t *testing.T) {
+		req := &service.CreateBookingRequest{
+			ProfessionalID: 1,
+			ClientID:       2,
+			ServiceID:      7,
+			StartTime:      futureTime,
 		}
+		offering := &professional.ServiceOffering{
+			ID:              7,
+			ProfessionalID:  1,
+			Name:            "Limpeza",
+			DurationMinutes: 90,
+			PriceCents:      15000,
+			Currency:        "BRL",
+			Active:          true,
+		}
+		expectedEnd := futureTime.Add(90 * time.Minute)
 
-		mockRepo.On("GetByID", ctx, bookingID).Return(expectedBooking, nil).Once()
+		mockRepo.On("GetServiceOffering", ctx, uint(7)).Return(offering, nil).Once()
+		mockRepo.On("ListAvailability", ctx, req.ProfessionalID).Return(fullWeek(req.ProfessionalID), nil).Once()
+		mockRepo.On("IsTimeSlotAvailable", ctx, req.ProfessionalID, futureTime, expectedEnd).Return(true, nil).Once()
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(r *repository.CreateBookingRequest) bool {
+			return r.EndTime.Equal(expectedEnd) && r.PriceCents == 15000 && r.Currency == "BRL" &&
+				r.ServiceID != nil && *r.ServiceID == 7
+		})).Return(&domain.Booking{ID: 3, EndTime: expectedEnd, PriceCents: 15000, Currency: "BRL"}, nil).Once()
 
-		result, err := bookingService.GetBooking(ctx, bookingID)
+		result, err := bookingService.CreateBooking(ctx, req)
 
 		assert.NoError(t, err)
-		assert.Equal(t, expectedBooking.ID, result.ID)
+		assert.Equal(t, int64(15000), result.PriceCents)
+		assert.Equal(t, expectedEnd, result.EndTime)
 		mockRepo.AssertExpectations(t)
 	})
 
-	t.Run("Error - booking not found", func(t *testing.T) {
-		bookingID := uint(999)
+	t.Run("Error - service of another professional", func(t *testing.T) {
+		req := &service.CreateBookingRequest{
+			ProfessionalID: 1,
+			ClientID:       2,
+			ServiceID:      8,
+			StartTime:      futureTime,
+		}
+		offering := &professional.ServiceOffering{ID: 8, ProfessionalID: 5, DurationMinutes: 60, Active: true}
 
-		mockRepo.On("GetByID", ctx, bookingID).Return(nil, domain.ErrBookingNotFound).Once()
+		mockRepo.On("GetServiceOffering", ctx, uint(8)).Return(offering, nil).Once()
+
+		result, err := bookingService.CreateBooking(ctx, req)
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrServiceUnavailable, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Success - no availability configured is unrestricted", func(t *testing.T) {
+		late := futureTime.Add(12 * time.Hour)
+		req := &service.CreateBookingRequest{
+			ProfessionalID: 1,
+			ClientID:       2,
+			StartTime:      late,
+			EndTime:        late.Add(time.Hour),
+		}
+
+		mockRepo.On("ListAvailability", ctx, req.ProfessionalID).Return([]*domain.Availability{}, nil).Once()
+		mockRepo.On("IsTimeSlotAvailable", ctx, req.ProfessionalID, req.StartTime, req.EndTime).Return(true, nil).Once()
+		mockRepo.On("Create", ctx, mock.AnythingOfType("*repository.CreateBookingRequest")).Return(&domain.Booking{ID: 9}, nil).Once()
+
+		result, err := bookingService.CreateBooking(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(9), result.ID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - outside working hours", func(t *testing.T) {
+		req := &service.CreateBookingRequest{
+			ProfessionalID: 1,
+			ClientID:       2,
+			StartTime:      futureTime,
+			EndTime:        futureTime.Add(time.Hour),
+		}
+
+		morning := []*domain.Availability{{
+			ProfessionalID: 1,
+			Weekday:        futureTime.Weekday(),
+			StartMinute:    8 * 60,
+			EndMinute:      10*60 + 30,
+		}}
+		mockRepo.On("ListAvailability", ctx, req.ProfessionalID).Return(morning, nil).Once()
+
+		result, err := bookingService.CreateBooking(ctx, req)
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrOutsideWorkingHours, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - client email not verified", func(t *testing.T) {
+		req := &service.CreateBookingRequest{
+			ProfessionalID: 1,
+			ClientID:       9,
+			StartTime:      futureTime,
+			EndTime:        futureTime.Add(time.Hour),
+		}
+
+		mockRepo.On("EmailVerified", ctx, domain.RoleClient, uint(9)).Return(false, nil).Once()
+
+		result, err := bookingService.CreateBooking(ctx, req)
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrEmailNotVerified, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	// Profissional 4 atende num raio de 10 km da Av. Paulista e no CEP 01310-xxx
+	centerLat, centerLng := -23.5614, -46.6559
+	mockRepo.On("ProfessionalServiceArea", ctx, uint(4)).Return(&geo.ServiceArea{
+		Latitude:    &centerLat,
+		Longitude:   &centerLng,
+		RadiusKm:    10,
+		PostalCodes: []string{"01310"},
+	}, nil).Maybe()
+
+	t.Run("Success - postal code inside service area is stored normalized", func(t *testing.T) {
+		req := &service.CreateBookingRequest{
+			ProfessionalID: 4,
+			ClientID:       2,
+			StartTime:      futureTime,
+			EndTime:        futureTime.Add(time.Hour),
+			ServiceAddress: &geo.Address{Street: "Av. Paulista", Number: "1000", City: "São Paulo", State: "sp", PostalCode: "01310-100"},
+		}
+
+		mockRepo.On("ListAvailability", ctx, uint(4)).Return(fullWeek(4), nil).Once()
+		mockRepo.On("IsTimeSlotAvailable", ctx, uint(4), req.StartTime, req.EndTime).Return(true, nil).Once()
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(r *repository.CreateBookingRequest) bool {
+			return r.ServiceAddress.PostalCode == "01310100" && r.ServiceAddress.State == "SP"
+		})).Return(&domain.Booking{ID: 5, ProfessionalID: 4, ServiceAddress: geo.Address{PostalCode: "01310100"}}, nil).Once()
+
+		result, err := bookingService.CreateBooking(ctx, req)
+
+		require.NoError(t, err)
+		require.NotNil(t, result.ServiceAddress)
+		assert.Equal(t, "01310100", result.ServiceAddress.PostalCode)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - address outside service area", func(t *testing.T) {
+		// Centro do Rio de Janeiro, a uns 360 km
+		lat, lng := -22.9068, -43.1729
+		req := &service.CreateBookingRequest{
+			ProfessionalID: 4,
+			ClientID:       2,
+			StartTime:      futureTime,
+			EndTime:        futureTime.Add(time.Hour),
+			ServiceAddress: &geo.Address{Street: "Av. Rio Branco", City: "Rio de Janeiro", State: "RJ", PostalCode: "20040-002", Latitude: &lat, Longitude: &lng},
+		}
+
+		result, err := bookingService.CreateBooking(ctx, req)
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrOutsideServiceArea, err)
+	})
+
+	t.Run("Error - restricted area requires address", func(t *testing.T) {
+		req := &service.CreateBookingRequest{
+			ProfessionalID: 4,
+			ClientID:       2,
+			StartTime:      futureTime,
+			EndTime:        futureTime.Add(time.Hour),
+		}
+
+		result, err := bookingService.CreateBooking(ctx, req)
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrServiceAddressRequired, err)
+	})
+}
+
+func TestBookingService_CreateAvailability(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockBookingRepository)
+	bookingService := service.NewBookingService(mockRepo, nil, nil, nil, nil)
+
+	existing := []*domain.Availability{
+		{ID: 1, ProfessionalID: 1, Weekday: time.Monday, StartMinute: 8 * 60, EndMinute: 12 * 60},
+	}
+
+	t.Run("Success - create window", func(t *testing.T) {
+		mockRepo.On("ListAvailability", ctx, uint(1)).Return(existing, nil).Once()
+		mockRepo.On("CreateAvailability", ctx, mock.AnythingOfType("*domain.Availability")).Return(nil).Once()
+
+		result, err := bookingService.CreateAvailability(ctx, 1, &service.AvailabilityRequest{
+			Weekday:   time.Monday,
+			StartTime: "13:30",
+			EndTime:   "18:00",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "13:30", result.StartTime)
+		assert.Equal(t, "18:00", result.EndTime)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - overlapping window", func(t *testing.T) {
+		mockRepo.On("ListAvailability", ctx, uint(1)).Return(existing, nil).Once()
+
+		result, err := bookingService.CreateAvailability(ctx, 1, &service.AvailabilityRequest{
+			Weekday:   time.Monday,
+			StartTime: "11:00",
+			EndTime:   "14:00",
+		})
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrInvalidAvailability, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - end before start", func(t *testing.T) {
+		result, err := bookingService.CreateAvailability(ctx, 1, &service.AvailabilityRequest{
+			Weekday:   time.Tuesday,
+			StartTime: "18:00",
+			EndTime:   "08:00",
+		})
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrInvalidAvailability, err)
+	})
+}
+
+func TestBookingService_ListAvailableSlots(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockBookingRepository)
+	bookingService := service.NewBookingService(mockRepo, nil, nil, nil, nil)
+	mockRepo.On("ProfessionalTimeZone", ctx, uint(1)).Return("UTC", nil).Maybe()
+
+	tomorrow := time.Now().AddDate(0, 0, 1)
+	day := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 0, 0, 0, 0, time.UTC)
+	windows := []*domain.Availability{
+		{ProfessionalID: 1, Weekday: day.Weekday(), StartMinute: 8 * 60, EndMinute: 12 * 60},
+	}
+
+	t.Run("Success - skips booked and cancelled bookings are free", func(t *testing.T) {
+		bookings := []*domain.Booking{
+			{ID: 1, StartTime: day.Add(9 * time.Hour), EndTime: day.Add(10 * time.Hour), Status: domain.StatusConfirmed},
+			{ID: 2, StartTime: day.Add(10 * time.Hour), EndTime: day.Add(11 * time.Hour), Status: domain.StatusCancelled},
+		}
+		mockRepo.On("ListAvailability", ctx, uint(1)).Return(windows, nil).Once()
+		mockRepo.On("ListByProfessional", ctx, uint(1), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+			Return(bookings, nil).Once()
+		mockRepo.On("ListTimeOff", ctx, uint(1), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+			Return(nil, nil).Once()
+
+		result, err := bookingService.ListAvailableSlots(ctx, 1, day, day.Add(24*time.Hour), time.Hour)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 3)
+		assert.Equal(t, day.Add(8*time.Hour), result[0].StartTime)
+		assert.Equal(t, day.Add(10*time.Hour), result[1].StartTime)
+		assert.Equal(t, day.Add(11*time.Hour), result[2].StartTime)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Success - no availability configured offers the whole day", func(t *testing.T) {
+		mockRepo.On("ListAvailability", ctx, uint(1)).Return(nil, nil).Once()
+		mockRepo.On("ListByProfessional", ctx, uint(1), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+			Return(nil, nil).Once()
+		mockRepo.On("ListTimeOff", ctx, uint(1), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+			Return(nil, nil).Once()
+
+		result, err := bookingService.ListAvailableSlots(ctx, 1, day, day.Add(24*time.Hour), time.Hour)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 24)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Success - skips time off", func(t *testing.T) {
+		timeOff := []*domain.TimeOff{
+			{ProfessionalID: 1, StartTime: day.Add(8 * time.Hour), EndTime: day.Add(10 * time.Hour), Reason: "Consulta médica"},
+		}
+		mockRepo.On("ListAvailability", ctx, uint(1)).Return(windows, nil).Once()
+		mockRepo.On("ListByProfessional", ctx, uint(1), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+			Return(nil, nil).Once()
+		mockRepo.On("ListTimeOff", ctx, uint(1), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+			Return(timeOff, nil).Once()
+
+		result, err := bookingService.ListAvailableSlots(ctx, 1, day, day.Add(24*time.Hour), time.Hour)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, day.Add(10*time.Hour), result[0].StartTime)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - range too large", func(t *testing.T) {
+		result, err := bookingService.ListAvailableSlots(ctx, 1, day, day.AddDate(0, 2, 0), time.Hour)
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrInvalidSlotQuery, err)
+	})
+}
+
+func TestBookingService_CreateRecurringBooking(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockBookingRepository)
+	bookingService := service.NewBookingService(mockRepo, nil, nil, nil, nil)
+	mockRepo.On("ProfessionalTimeZone", ctx, uint(1)).Return("UTC", nil).Maybe()
+	mockRepo.On("ProfessionalServiceArea", ctx, uint(1)).Return(&geo.ServiceArea{}, nil).Maybe()
+	mockRepo.On("EmailVerified", ctx, domain.RoleClient, uint(2)).Return(true, nil).Maybe()
+
+	tomorrow := time.Now().AddDate(0, 0, 1)
+	start := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 10, 0, 0, 0, time.UTC)
+
+	newRequest := func() *service.CreateRecurringBookingRequest {
+		return &service.CreateRecurringBookingRequest{
+			CreateBookingRequest: service.CreateBookingRequest{
+				ProfessionalID: 1,
+				ClientID:       2,
+				StartTime:      start,
+				EndTime:        start.Add(time.Hour),
+			},
+			Recurrence: domain.Recurrence{Frequency: domain.FrequencyWeekly, Count: 4},
+		}
+	}
+
+	t.Run("Success - creates every occurrence", func(t *testing.T) {
+		created := []*domain.Booking{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}}
+
+		mockRepo.On("ListAvailability", ctx, uint(1)).Return(fullWeek(1), nil).Once()
+		mockRepo.On("CreateSeries", ctx, mock.AnythingOfType("*domain.BookingSeries"),
+			mock.MatchedBy(func(occurrences []*repository.CreateBookingRequest) bool {
+				return len(occurrences) == 4 &&
+					occurrences[3].StartTime.Equal(start.AddDate(0, 0, 21)) &&
+					occurrences[3].EndTime.Equal(start.AddDate(0, 0, 21).Add(time.Hour))
+			})).Return(created, nil).Once()
+
+		result, err := bookingService.CreateRecurringBooking(ctx, newRequest())
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Bookings, 4)
+		assert.Equal(t, domain.FrequencyWeekly, result.Series.Frequency)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - reports occurrences outside working hours", func(t *testing.T) {
+		onlyStartDay := []*domain.Availability{{
+			ProfessionalID: 1,
+			Weekday:        start.Weekday(),
+			StartMinute:    0,
+			EndMinute:      24 * 60,
+		}}
+		req := newRequest()
+		req.Recurrence = domain.Recurrence{Frequency: domain.FrequencyMonthly, Count: 3}
+
+		mockRepo.On("ListAvailability", ctx, uint(1)).Return(onlyStartDay, nil).Once()
+
+		result, err := bookingService.CreateRecurringBooking(ctx, req)
+
+		assert.Nil(t, result)
+		var conflictErr *domain.SeriesConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.ErrorIs(t, err, domain.ErrTimeSlotUnavailable)
+		for _, c := range conflictErr.Conflicts {
+			assert.NotEqual(t, start.Weekday(), c.StartTime.Weekday())
+		}
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - count and until together", func(t *testing.T) {
+		until := start.AddDate(0, 2, 0)
+		req := newRequest()
+		req.Recurrence.Until = &until
+
+		result, err := bookingService.CreateRecurringBooking(ctx, req)
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrInvalidRecurrence, err)
+	})
+}
+
+func TestBookingService_CancelBooking(t *testing.T) {
+	ctx := domain.WithActor(context.Background(), domain.Actor{ID: 2, Role: domain.RoleClient})
+	mockRepo := new(MockBookingRepository)
+	bookingService := service.NewBookingService(mockRepo, nil, nil, nil, nil)
+
+	t.Run("Success - cancel single occurrence", func(t *testing.T) {
+		mockRepo.On("GetByID", ctx, uint(1)).Return(&domain.Booking{ID: 1, ProfessionalID: 1, ClientID: 2, Status: domain.StatusConfirmed}, nil).Once()
+		mockRepo.On("UpdateStatus", ctx, uint(1), domain.StatusCancelled, "").
+			Return(&domain.Booking{ID: 1, ProfessionalID: 1, Status: domain.StatusCancelled}, nil).Once()
+		mockRepo.On("GetCancellationPolicy", ctx, uint(1)).Return(domain.DefaultCancellationPolicy(1), nil).Once()
+
+		result, err := bookingService.CancelBooking(ctx, 1, domain.CancelOccurrence, "")
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Bookings, 1)
+		assert.Zero(t, result.FeeCents)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Success - cancel whole series", func(t *testing.T) {
+		seriesID := uint(9)
+		mockRepo.On("GetByID", ctx, uint(1)).Return(&domain.Booking{ID: 1, ProfessionalID: 1, ClientID: 2, SeriesID: &seriesID}, nil).Once()
+		mockRepo.On("CancelSeries", ctx, seriesID, mock.AnythingOfType("time.Time"), "cliente mudou de cidade").
+			Return([]*domain.Booking{{ID: 1, ProfessionalID: 1}, {ID: 2, ProfessionalID: 1}}, nil).Once()
+		mockRepo.On("GetCancellationPolicy", ctx, uint(1)).Return(domain.DefaultCancellationPolicy(1), nil).Once()
+
+		result, err := bookingService.CancelBooking(ctx, 1, domain.CancelSeries, "cliente mudou de cidade")
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Bookings, 2)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - series scope without series", func(t *testing.T) {
+		mockRepo.On("GetByID", ctx, uint(2)).Return(&domain.Booking{ID: 2, ProfessionalID: 1, ClientID: 2}, nil).Once()
+
+		_, err := bookingService.CancelBooking(ctx, 2, domain.CancelSeries, "")
+
+		assert.Equal(t, domain.ErrBookingNotInSeries, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - another client's booking", func(t *testing.T) {
+		mockRepo.On("GetByID", ctx, uint(3)).Return(&domain.Booking{ID: 3, ProfessionalID: 1, ClientID: 7, Status: domain.StatusConfirmed}, nil).Once()
+
+		result, err := bookingService.CancelBooking(ctx, 3, domain.CancelOccurrence, "")
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrForbidden, err)
+		mockRepo.AssertNotCalled(t, "UpdateStatus", ctx, uint(3), domain.StatusCancelled, "")
+	})
+}
+
+func TestBookingService_CancelBooking_Policy(t *testing.T) {
+	ctx := context.Background()
+	policy := &domain.CancellationPolicy{ProfessionalID: 1, FreeCancellationHours: 24, LateCancellationFee: 50}
+	soon := &domain.Booking{
+		ID:             3,
+		ProfessionalID: 1,
+		ClientID:       2,
+		StartTime:      time.Now().Add(2 * time.Hour),
+		EndTime:        time.Now().Add(3 * time.Hour),
+		Status:         domain.StatusCancelled,
+		PriceCents:     10000,
+		Currency:       "BRL",
+	}
+
+	t.Run("Success - late cancellation charges fee and settles payment", func(t *testing.T) {
+		mockRepo := new(MockBookingRepository)
+		mockPayments := new(MockPaymentSettler)
+		bookingService := service.NewBookingService(mockRepo, mockPayments, nil, nil, nil)
+		clientCtx := domain.WithActor(ctx, domain.Actor{ID: 2, Role: "user"})
+
+		mockRepo.On("GetByID", clientCtx, uint(3)).Return(soon, nil).Once()
+		mockRepo.On("UpdateStatus", clientCtx, uint(3), domain.StatusCancelled, "").Return(soon, nil).Once()
+		mockRepo.On("GetCancellationPolicy", clientCtx, uint(1)).Return(policy, nil).Once()
+		mockRepo.On("OfferWaitlistSlot", clientCtx, domain.FreedSlot(soon), mock.AnythingOfType("time.Time")).Return(nil, nil).Once()
+		mockPayments.On("SettleCancellation", "2", "3", int64(5000)).Return(nil, nil).Once()
+
+		result, err := bookingService.CancelBooking(clientCtx, 3, domain.CancelOccurrence, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5000), result.FeeCents)
+		assert.Equal(t, "BRL", result.Currency)
+		mockRepo.AssertExpectations(t)
+		mockPayments.AssertExpectations(t)
+	})
+
+	t.Run("Success - professional cancellation waives fee", func(t *testing.T) {
+		mockRepo := new(MockBookingRepository)
+		mockPayments := new(MockPaymentSettler)
+		bookingService := service.NewBookingService(mockRepo, mockPayments, nil, nil, nil)
+		professionalCtx := domain.WithActor(ctx, domain.Actor{ID: 1, Role: "professional"})
+
+		mockRepo.On("GetByID", professionalCtx, uint(3)).Return(soon, nil).Once()
+		mockRepo.On("UpdateStatus", professionalCtx, uint(3), domain.StatusCancelled, "").Return(soon, nil).Once()
+		mockRepo.On("GetCancellationPolicy", professionalCtx, uint(1)).Return(policy, nil).Once()
+		mockRepo.On("OfferWaitlistSlot", professionalCtx, domain.FreedSlot(soon), mock.AnythingOfType("time.Time")).Return(nil, nil).Once()
+		mockPayments.On("SettleCancellation", "2", "3", int64(0)).Return(nil, nil).Once()
+
+		result, err := bookingService.CancelBooking(professionalCtx, 3, domain.CancelOccurrence, "")
+
+		assert.NoError(t, err)
+		assert.Zero(t, result.FeeCents)
+		mockPayments.AssertExpectations(t)
+	})
+}
+
+func TestBookingService_UpdateCancellationPolicy(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockBookingRepository)
+	bookingService := service.NewBookingService(mockRepo, nil, nil, nil, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		req := &service.CancellationPolicyRequest{FreeCancellationHours: 12, LateCancellationFee: 30, NoShowFee: 100}
+		mockRepo.On("SaveCancellationPolicy", ctx, mock.AnythingOfType("*domain.CancellationPolicy")).Return(nil).Once()
+
+		policy, err := bookingService.UpdateCancellationPolicy(ctx, 1, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(1), policy.ProfessionalID)
+		assert.Equal(t, 30, policy.LateCancellationFee)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - percent above 100", func(t *testing.T) {
+		req := &service.CancellationPolicyRequest{LateCancellationFee: 150}
+
+		_, err := bookingService.UpdateCancellationPolicy(ctx, 1, req)
+
+		assert.Equal(t, domain.ErrInvalidCancellationPolicy, err)
+	})
+}
+
+func TestBookingService_RescheduleBooking(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockBookingRepository)
+	bookingService := service.NewBookingService(mockRepo, nil, nil, nil, nil)
+	mockRepo.On("ProfessionalTimeZone", mock.Anything, uint(1)).Return("America/Sao_Paulo", nil).Maybe()
+
+	tomorrow := time.Now().AddDate(0, 0, 1)
+	start := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 10, 0, 0, 0, time.Local)
+	current := &domain.Booking{
+		ID:             1,
+		ProfessionalID: 1,
+		ClientID:       2,
+		StartTime:      start,
+		EndTime:        start.Add(90 * time.Minute),
+		Status:         domain.StatusConfirmed,
+	}
+
+	t.Run("Success - keeps duration and records actor", func(t *testing.T) {
+		actor := domain.Actor{ID: 2, Role: "user"}
+		actorCtx := domain.WithActor(ctx, actor)
+		newStart := start.Add(3 * time.Hour)
+		newEnd := newStart.Add(90 * time.Minute)
+
+		mockRepo.On("GetByID", actorCtx, uint(1)).Return(current, nil).Once()
+		mockRepo.On("ListAvailability", actorCtx, uint(1)).Return(fullWeek(1), nil).Once()
+		mockRepo.On("Reschedule", actorCtx, uint(1), newStart, newEnd, actor).
+			Return(&domain.Booking{ID: 1, StartTime: newStart, EndTime: newEnd, Status: domain.StatusConfirmed}, nil).Once()
+
+		result, err := bookingService.RescheduleBooking(actorCtx, 1, newStart, time.Time{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(1), result.ID)
+		assert.Equal(t, newEnd, result.EndTime)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - slot taken", func(t *testing.T) {
+		actor := domain.Actor{ID: 1, Role: domain.RoleProfessional}
+		actorCtx := domain.WithActor(ctx, actor)
+		newStart := start.Add(time.Hour)
+		newEnd := newStart.Add(time.Hour)
+
+		mockRepo.On("GetByID", actorCtx, uint(1)).Return(current, nil).Once()
+		mockRepo.On("ListAvailability", actorCtx, uint(1)).Return(fullWeek(1), nil).Once()
+		mockRepo.On("Reschedule", actorCtx, uint(1), newStart, newEnd, actor).
+			Return(nil, domain.ErrTimeSlotUnavailable).Once()
+
+		result, err := bookingService.RescheduleBooking(actorCtx, 1, newStart, newEnd)
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrTimeSlotUnavailable, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - cancelled booking", func(t *testing.T) {
+		actorCtx := domain.WithActor(ctx, domain.Actor{ID: 2, Role: domain.RoleClient})
+		cancelled := *current
+		cancelled.Status = domain.StatusCancelled
+		mockRepo.On("GetByID", actorCtx, uint(1)).Return(&cancelled, nil).Once()
+
+		result, err := bookingService.RescheduleBooking(actorCtx, 1, start.Add(time.Hour), time.Time{})
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrBookingNotActive, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - invalid new time", func(t *testing.T) {
+		actorCtx := domain.WithActor(ctx, domain.Actor{ID: 2, Role: domain.RoleClient})
+		tests := []struct {
+			name     string
+			newStart time.Time
+			newEnd   time.Time
+			want     error
+		}{
+			{"start in the past", time.Now().Add(-time.Hour), time.Time{}, domain.ErrStartInPast},
+			{"end before start", start.Add(time.Hour), start, domain.ErrInvalidTimeRange},
+			{"empty range", start.Add(time.Hour), start.Add(time.Hour), domain.ErrInvalidTimeRange},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				mockRepo.On("GetByID", actorCtx, uint(1)).Return(current, nil).Once()
+
+				result, err := bookingService.RescheduleBooking(actorCtx, 1, tt.newStart, tt.newEnd)
+
+				assert.Nil(t, result)
+				assert.Equal(t, tt.want, err)
+			})
+		}
+	})
+
+	t.Run("Error - not a participant", func(t *testing.T) {
+		// Cliente com o mesmo ID do profissional não ganha acesso
+		actorCtx := domain.WithActor(ctx, domain.Actor{ID: 1, Role: domain.RoleClient})
+		mockRepo.On("GetByID", actorCtx, uint(1)).Return(current, nil).Once()
+
+		result, err := bookingService.RescheduleBooking(actorCtx, 1, start.Add(time.Hour), time.Time{})
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrForbidden, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestBookingService_GetBookingHistory(t *testing.T) {
+	ctx := domain.WithActor(context.Background(), domain.Actor{ID: 3, Role: domain.RoleProfessional})
+	mockRepo := new(MockBookingRepository)
+	bookingService := service.NewBookingService(mockRepo, nil, nil, nil, nil)
+
+	t.Run("Success - events and reschedules", func(t *testing.T) {
+		events := []*domain.BookingEvent{
+			{BookingID: 1, NewStatus: domain.StatusPending},
+			{BookingID: 1, ActorID: 3, ActorRole: "professional", OldStatus: domain.StatusPending, NewStatus: domain.StatusConfirmed},
+		}
+		mockRepo.On("GetByID", ctx, uint(1)).Return(&domain.Booking{ID: 1, ProfessionalID: 3}, nil).Once()
+		mockRepo.On("ListEvents", ctx, uint(1)).Return(events, nil).Once()
+		mockRepo.On("ListHistory", ctx, uint(1)).Return(nil, nil).Once()
+
+		result, err := bookingService.GetBookingHistory(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Events, 2)
+		assert.Equal(t, "professional", result.Events[1].ActorRole)
+		assert.NotNil(t, result.Reschedules)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - booking not found", func(t *testing.T) {
+		mockRepo.On("GetByID", ctx, uint(2)).Return(nil, domain.ErrBookingNotFound).Once()
+
+		result, err := bookingService.GetBookingHistory(ctx, 2)
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrBookingNotFound, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestBookingService_GetBooking(t *testing.T) {
+	ctx := domain.WithActor(context.Background(), domain.Actor{ID: 1, Role: domain.RoleProfessional})
+	mockRepo := new(MockBookingRepository)
+	bookingService := service.NewBookingService(mockRepo, nil, nil, nil, nil)
+
+	t.Run("Success - get booking", func(t *testing.T) {
+		bookingID := uint(1)
+		expectedBooking := &domain.Booking{
+			ID:             bookingID,
+			ProfessionalID: 1,
+			Status:         domain.StatusConfirmed,
+		}
+
+		mockRepo.On("GetByID", ctx, bookingID).Return(expectedBooking, nil).Once()
+
+		result, err := bookingService.GetBooking(ctx, bookingID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedBooking.ID, result.ID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - booking not found", func(t *testing.T) {
+		bookingID := uint(999)
+
+		mockRepo.On("GetByID", ctx, bookingID).Return(nil, domain.ErrBookingNotFound).Once()
 
 		result, err := bookingService.GetBooking(ctx, bookingID)
 
@@ -297,12 +1370,22 @@ func TestBookingService_GetBooking(t *testing.T) {
 		assert.Equal(t, assert.AnError, err)
 		mockRepo.AssertExpectations(t)
 	})
+
+	t.Run("Error - booking of another professional", func(t *testing.T) {
+		mockRepo.On("GetByID", ctx, uint(5)).Return(&domain.Booking{ID: 5, ProfessionalID: 9, ClientID: 1}, nil).Once()
+
+		result, err := bookingService.GetBooking(ctx, 5)
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrForbidden, err)
+		mockRepo.AssertExpectations(t)
+	})
 }
 
 func TestBookingService_UpdateBookingStatus(t *testing.T) {
-	ctx := context.Background()
+	ctx := domain.WithActor(context.Background(), domain.Actor{ID: 1, Role: domain.RoleProfessional})
 	mockRepo := new(MockBookingRepository)
-	bookingService := service.NewBookingService(mockRepo)
+	bookingService := service.NewBookingService(mockRepo, nil, nil, nil, nil)
 
 	t.Run("Success - update status", func(t *testing.T) {
 		bookingID := uint(1)
@@ -312,9 +1395,10 @@ func TestBookingService_UpdateBookingStatus(t *testing.T) {
 			Status: newStatus,
 		}
 
-		mockRepo.On("UpdateStatus", ctx, bookingID, newStatus).Return(expectedBooking, nil).Once()
+		mockRepo.On("GetByID", ctx, bookingID).Return(&domain.Booking{ID: bookingID, ProfessionalID: 1, Status: domain.StatusPending}, nil).Once()
+		mockRepo.On("UpdateStatus", ctx, bookingID, newStatus, "").Return(expectedBooking, nil).Once()
 
-		result, err := bookingService.UpdateBookingStatus(ctx, bookingID, newStatus)
+		result, err := bookingService.UpdateBookingStatus(ctx, bookingID, newStatus, "")
 
 		assert.NoError(t, err)
 		assert.Equal(t, expectedBooking.ID, result.ID)
@@ -326,9 +1410,9 @@ func TestBookingService_UpdateBookingStatus(t *testing.T) {
 		bookingID := uint(1)
 		newStatus := domain.StatusCompleted
 
-		mockRepo.On("UpdateStatus", ctx, bookingID, newStatus).Return(nil, domain.ErrInvalidStatusTransition).Once()
+		mockRepo.On("GetByID", ctx, bookingID).Return(&domain.Booking{ID: bookingID, ProfessionalID: 1, Status: domain.StatusPending}, nil).Once()
 
-		result, err := bookingService.UpdateBookingStatus(ctx, bookingID, newStatus)
+		result, err := bookingService.UpdateBookingStatus(ctx, bookingID, newStatus, "")
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -340,13 +1424,593 @@ func TestBookingService_UpdateBookingStatus(t *testing.T) {
 		bookingID := uint(1)
 		newStatus := domain.StatusConfirmed
 
-		mockRepo.On("UpdateStatus", ctx, bookingID, newStatus).Return(nil, assert.AnError).Once()
+		mockRepo.On("GetByID", ctx, bookingID).Return(&domain.Booking{ID: bookingID, ProfessionalID: 1, Status: domain.StatusPending}, nil).Once()
+		mockRepo.On("UpdateStatus", ctx, bookingID, newStatus, "").Return(nil, assert.AnError).Once()
 
-		result, err := bookingService.UpdateBookingStatus(ctx, bookingID, newStatus)
+		result, err := bookingService.UpdateBookingStatus(ctx, bookingID, newStatus, "")
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
 		assert.Equal(t, assert.AnError, err)
 		mockRepo.AssertExpectations(t)
 	})
-}
\ No newline at end of file
+
+	t.Run("Error - cannot complete before start", func(t *testing.T) {
+		upcoming := &domain.Booking{ID: 2, ProfessionalID: 1, Status: domain.StatusConfirmed, StartTime: time.Now().Add(time.Hour)}
+		mockRepo.On("GetByID", ctx, uint(2)).Return(upcoming, nil).Once()
+
+		_, err := bookingService.UpdateBookingStatus(ctx, 2, domain.StatusCompleted, "")
+
+		assert.Equal(t, domain.ErrTransitionTooEarly, err)
+		mockRepo.AssertNotCalled(t, "UpdateStatus", ctx, uint(2), domain.StatusCompleted, "")
+	})
+
+	t.Run("Error - no-show before grace period", func(t *testing.T) {
+		started := &domain.Booking{ID: 3, ProfessionalID: 1, Status: domain.StatusConfirmed, StartTime: time.Now().Add(-5 * time.Minute)}
+		mockRepo.On("GetByID", ctx, uint(3)).Return(started, nil).Once()
+
+		_, err := bookingService.UpdateBookingStatus(ctx, 3, domain.StatusNoShow, "")
+
+		assert.Equal(t, domain.ErrTransitionTooEarly, err)
+	})
+
+	t.Run("Success - check-in moves to in progress", func(t *testing.T) {
+		now := &domain.Booking{ID: 4, ProfessionalID: 1, Status: domain.StatusConfirmed, StartTime: time.Now().Add(5 * time.Minute)}
+		mockRepo.On("GetByID", ctx, uint(4)).Return(now, nil).Once()
+		mockRepo.On("UpdateStatus", ctx, uint(4), domain.StatusInProgress, "").
+			Return(&domain.Booking{ID: 4, Status: domain.StatusInProgress}, nil).Once()
+
+		result, err := bookingService.UpdateBookingStatus(ctx, 4, domain.StatusInProgress, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.StatusInProgress, result.Status)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - client cannot confirm", func(t *testing.T) {
+		clientCtx := domain.WithActor(context.Background(), domain.Actor{ID: 2, Role: domain.RoleClient})
+		pending := &domain.Booking{ID: 5, ProfessionalID: 1, ClientID: 2, Status: domain.StatusPending}
+		mockRepo.On("GetByID", clientCtx, uint(5)).Return(pending, nil).Once()
+
+		result, err := bookingService.UpdateBookingStatus(clientCtx, 5, domain.StatusConfirmed, "")
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrForbidden, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - professional of another booking", func(t *testing.T) {
+		other := &domain.Booking{ID: 6, ProfessionalID: 8, ClientID: 2, Status: domain.StatusPending}
+		mockRepo.On("GetByID", ctx, uint(6)).Return(other, nil).Once()
+
+		result, err := bookingService.UpdateBookingStatus(ctx, 6, domain.StatusConfirmed, "")
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrForbidden, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestBookingService_UpdateBookingStatus_NoShowCharge(t *testing.T) {
+	ctx := domain.WithActor(context.Background(), domain.Actor{ID: 1, Role: domain.RoleProfessional})
+	mockRepo := new(MockBookingRepository)
+	mockPayments := new(MockPaymentSettler)
+	bookingService := service.NewBookingService(mockRepo, mockPayments, nil, nil, nil)
+
+	missed := &domain.Booking{
+		ID:             6,
+		ProfessionalID: 1,
+		ClientID:       2,
+		Status:         domain.StatusConfirmed,
+		StartTime:      time.Now().Add(-time.Hour),
+		PriceCents:     8000,
+	}
+	noShow := *missed
+	noShow.Status = domain.StatusNoShow
+
+	mockRepo.On("GetByID", ctx, uint(6)).Return(missed, nil).Once()
+	mockRepo.On("UpdateStatus", ctx, uint(6), domain.StatusNoShow, "").Return(&noShow, nil).Once()
+	mockRepo.On("GetCancellationPolicy", ctx, uint(1)).
+		Return(&domain.CancellationPolicy{ProfessionalID: 1, NoShowFee: 100}, nil).Once()
+	mockPayments.On("SettleCancellation", "2", "6", int64(8000)).Return(nil, nil).Once()
+
+	result, err := bookingService.UpdateBookingStatus(ctx, 6, domain.StatusNoShow, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.StatusNoShow, result.Status)
+	mockRepo.AssertExpectations(t)
+	mockPayments.AssertExpectations(t)
+}
+
+func TestBookingService_UpdateBookingStatus_NoShowChargeFailure(t *testing.T) {
+	ctx := domain.WithActor(context.Background(), domain.Actor{ID: 1, Role: domain.RoleProfessional})
+	mockRepo := new(MockBookingRepository)
+	mockPayments := new(MockPaymentSettler)
+	bookingService := service.NewBookingService(mockRepo, mockPayments, nil, nil, nil)
+
+	missed := &domain.Booking{ID: 7, ProfessionalID: 1, ClientID: 2, Status: domain.StatusConfirmed, StartTime: time.Now().Add(-time.Hour), PriceCents: 8000}
+	noShow := *missed
+	noShow.Status = domain.StatusNoShow
+
+	mockRepo.On("GetByID", ctx, uint(7)).Return(missed, nil).Once()
+	mockRepo.On("UpdateStatus", ctx, uint(7), domain.StatusNoShow, "").Return(&noShow, nil).Once()
+	mockRepo.On("GetCancellationPolicy", ctx, uint(1)).
+		Return(&domain.CancellationPolicy{ProfessionalID: 1, NoShowFee: 100}, nil).Once()
+	mockPayments.On("SettleCancellation", "2", "7", int64(8000)).Return(nil, assert.AnError).Once()
+
+	// O status já foi gravado; a falha do gateway não vira erro da requisição
+	result, err := bookingService.UpdateBookingStatus(ctx, 7, domain.StatusNoShow, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.StatusNoShow, result.Status)
+	mockRepo.AssertExpectations(t)
+	mockPayments.AssertExpectations(t)
+}
+
+func TestBookingService_UpdateBookingStatus_CancelRefunds(t *testing.T) {
+	ctx := domain.WithActor(context.Background(), domain.Actor{ID: 1, Role: domain.RoleProfessional})
+	mockRepo := new(MockBookingRepository)
+	mockPayments := new(MockPaymentSettler)
+	bookingService := service.NewBookingService(mockRepo, mockPayments, nil, nil, nil)
+
+	// Dentro da janela de multa: o cliente pagaria, mas quem cancela é o profissional
+	soon := &domain.Booking{
+		ID:             8,
+		ProfessionalID: 1,
+		ClientID:       2,
+		Status:         domain.StatusConfirmed,
+		StartTime:      time.Now().Add(2 * time.Hour),
+		EndTime:        time.Now().Add(3 * time.Hour),
+		PriceCents:     10000,
+		Currency:       "BRL",
+	}
+	cancelled := *soon
+	cancelled.Status = domain.StatusCancelled
+
+	mockRepo.On("GetByID", ctx, uint(8)).Return(soon, nil).Once()
+	mockRepo.On("UpdateStatus", ctx, uint(8), domain.StatusCancelled, "imprevisto").Return(&cancelled, nil).Once()
+	mockRepo.On("OfferWaitlistSlot", ctx, domain.FreedSlot(&cancelled), mock.AnythingOfType("time.Time")).Return(nil, nil).Once()
+	mockRepo.On("GetCancellationPolicy", ctx, uint(1)).
+		Return(&domain.CancellationPolicy{ProfessionalID: 1, FreeCancellationHours: 24, LateCancellationFee: 50}, nil).Once()
+	mockPayments.On("SettleCancellation", "2", "8", int64(0)).Return(nil, nil).Once()
+
+	result, err := bookingService.UpdateBookingStatus(ctx, 8, domain.StatusCancelled, "imprevisto")
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.StatusCancelled, result.Status)
+	mockRepo.AssertExpectations(t)
+	mockPayments.AssertExpectations(t)
+}
+
+func TestBookingService_UpdateBookingStatus_ConfirmationEmail(t *testing.T) {
+	ctx := domain.WithActor(context.Background(), domain.Actor{ID: 1, Role: domain.RoleProfessional})
+	mockRepo := new(MockBookingRepository)
+	mailer := email.NewMemoryMailer()
+	bookingService := service.NewBookingService(mockRepo, nil, nil, mailer, nil)
+
+	start := time.Date(2030, 3, 4, 13, 0, 0, 0, time.UTC)
+	pending := &domain.Booking{ID: 9, ProfessionalID: 1, ClientID: 2, StartTime: start, EndTime: start.Add(time.Hour), Status: domain.StatusPending}
+	confirmed := *pending
+	confirmed.Status = domain.StatusConfirmed
+	confirmed.PriceCents = 15000
+	confirmed.Currency = "BRL"
+
+	mockRepo.On("GetByID", ctx, uint(9)).Return(pending, nil).Once()
+	mockRepo.On("UpdateStatus", ctx, uint(9), domain.StatusConfirmed, "").Return(&confirmed, nil).Once()
+	// O e-mail sai em segundo plano, com um contexto próprio
+	mockRepo.On("ClientContact", mock.Anything, uint(2)).Return(&domain.Contact{
+		Name: "Ana", Email: "ana@email.com", Locale: "en", TimeZone: "America/Sao_Paulo",
+	}, nil).Once()
+	mockRepo.On("ProfessionalNames", mock.Anything, []uint{1}).Return(map[uint]string{1: "João"}, nil).Once()
+
+	_, err := bookingService.UpdateBookingStatus(ctx, 9, domain.StatusConfirmed, "")
+
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return len(mailer.Messages()) == 1 }, time.Second, 5*time.Millisecond)
+	sent := mailer.Messages()
+	assert.Equal(t, "ana@email.com", sent[0].To)
+	assert.Contains(t, sent[0].Subject, "Booking #9 confirmed")
+	// 13:00 UTC é 10:00 em São Paulo
+	assert.Contains(t, sent[0].Text, "Mar 4, 2030 at 10:00 AM")
+	assert.Contains(t, sent[0].Text, "R$ 150.00")
+	assert.Contains(t, sent[0].HTML, "João")
+	mockRepo.AssertExpectations(t)
+}
+
+func TestBookingService_ExpirePendingBookings(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success - expires and notifies both parties", func(t *testing.T) {
+		mockRepo := new(MockBookingRepository)
+		mockNotifier := new(MockNotifier)
+		bookingService := service.NewBookingService(mockRepo, nil, mockNotifier, nil, nil)
+
+		expired := []*domain.Booking{{ID: 5, ProfessionalID: 1, ClientID: 2, Status: domain.StatusExpired}}
+		mockRepo.On("ExpirePending", mock.Anything, mock.MatchedBy(func(cutoff time.Time) bool {
+			return cutoff.Before(time.Now().Add(-59 * time.Minute))
+		})).Return(expired, nil).Once()
+		mockNotifier.On("SendNotification", mock.MatchedBy(func(n notification.Notification) bool {
+			return n.ReceiverType == "client" && n.ReceiverID == 2 && n.ID == 5
+		})).Once()
+		mockNotifier.On("SendNotification", mock.MatchedBy(func(n notification.Notification) bool {
+			return n.ReceiverType == "professional" && n.ReceiverID == 1 && n.ID == 5
+		})).Once()
+
+		count, err := bookingService.ExpirePendingBookings(ctx, time.Hour)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+		mockRepo.AssertExpectations(t)
+		mockNotifier.AssertExpectations(t)
+	})
+
+	t.Run("Success - nothing to expire", func(t *testing.T) {
+		mockRepo := new(MockBookingRepository)
+		mockNotifier := new(MockNotifier)
+		bookingService := service.NewBookingService(mockRepo, nil, mockNotifier, nil, nil)
+
+		mockRepo.On("ExpirePending", mock.Anything, mock.AnythingOfType("time.Time")).Return(nil, nil).Once()
+
+		count, err := bookingService.ExpirePendingBookings(ctx, time.Hour)
+
+		assert.NoError(t, err)
+		assert.Zero(t, count)
+		mockNotifier.AssertNotCalled(t, "SendNotification", mock.Anything)
+	})
+}
+
+func TestBookingService_CreateTimeOff(t *testing.T) {
+	ctx := context.Background()
+	start := time.Now().AddDate(0, 0, 7)
+	affected := &domain.Booking{ID: 8, ProfessionalID: 1, ClientID: 2, StartTime: start.Add(time.Hour), EndTime: start.Add(2 * time.Hour), Status: domain.StatusConfirmed}
+
+	newRequest := func(force bool) *service.TimeOffRequest {
+		return &service.TimeOffRequest{StartTime: start, EndTime: start.Add(48 * time.Hour), Reason: "Férias", Force: force}
+	}
+
+	t.Run("Error - overlaps bookings without force", func(t *testing.T) {
+		mockRepo := new(MockBookingRepository)
+		bookingService := service.NewBookingService(mockRepo, nil, nil, nil, nil)
+		conflict := &domain.TimeOffConflictError{Bookings: []*domain.Booking{affected}}
+		mockRepo.On("CreateTimeOff", mock.Anything, mock.AnythingOfType("*domain.TimeOff"), false).Return(nil, conflict).Once()
+
+		result, err := bookingService.CreateTimeOff(ctx, 1, newRequest(false))
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrTimeOffConflict)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Success - force cancels, refunds and notifies clients", func(t *testing.T) {
+		mockRepo := new(MockBookingRepository)
+		mockPayments := new(MockPaymentSettler)
+		mockNotifier := new(MockNotifier)
+		bookingService := service.NewBookingService(mockRepo, mockPayments, mockNotifier, nil, nil)
+
+		cancelled := *affected
+		cancelled.Status = domain.StatusCancelled
+		mockRepo.On("CreateTimeOff", mock.Anything, mock.AnythingOfType("*domain.TimeOff"), true).
+			Return([]*domain.Booking{&cancelled}, nil).Once()
+		mockRepo.On("GetCancellationPolicy", mock.Anything, uint(1)).Return(domain.DefaultCancellationPolicy(1), nil).Once()
+		mockPayments.On("SettleCancellation", "2", "8", int64(0)).Return(nil, nil).Once()
+		mockNotifier.On("SendNotification", mock.MatchedBy(func(n notification.Notification) bool {
+			return n.ReceiverType == "client" && n.ReceiverID == 2
+		})).Once()
+
+		result, err := bookingService.CreateTimeOff(ctx, 1, newRequest(true))
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(1), result.TimeOff.ProfessionalID)
+		assert.Len(t, result.CancelledBookings, 1)
+		mockRepo.AssertExpectations(t)
+		mockPayments.AssertExpectations(t)
+		mockNotifier.AssertExpectations(t)
+	})
+
+	t.Run("Error - end before start", func(t *testing.T) {
+		bookingService := service.NewBookingService(new(MockBookingRepository), nil, nil, nil, nil)
+		req := newRequest(false)
+		req.EndTime = req.StartTime.Add(-time.Hour)
+
+		_, err := bookingService.CreateTimeOff(ctx, 1, req)
+
+		assert.Equal(t, domain.ErrInvalidTimeOff, err)
+	})
+}
+
+func TestBookingService_ProfessionalCalendar(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockBookingRepository)
+	bookingService := service.NewBookingService(mockRepo, nil, nil, nil, nil)
+
+	var saved *domain.CalendarToken
+	mockRepo.On("SaveCalendarToken", ctx, mock.AnythingOfType("*domain.CalendarToken")).
+		Run(func(args mock.Arguments) { saved = args.Get(1).(*domain.CalendarToken) }).
+		Return(nil).Once()
+
+	token, err := bookingService.RotateCalendarToken(ctx, 1)
+	require.NoError(t, err)
+	require.NotEqual(t, token, saved.TokenHash, "only the hash is stored")
+
+	t.Run("Success - renders bookings as VEVENTs", func(t *testing.T) {
+		start := time.Date(2030, 5, 10, 13, 0, 0, 0, time.UTC)
+		bookings := []*domain.Booking{
+			{ID: 42, ProfessionalID: 1, ClientID: 2, StartTime: start, EndTime: start.Add(time.Hour), Status: domain.StatusConfirmed},
+			{ID: 43, ProfessionalID: 1, ClientID: 3, StartTime: start.Add(2 * time.Hour), EndTime: start.Add(3 * time.Hour), Status: domain.StatusCancelled},
+		}
+		mockRepo.On("GetCalendarToken", ctx, uint(1)).Return(saved, nil).Once()
+		mockRepo.On("ListByProfessional", ctx, uint(1), mock.AnythingOfType("time.Time"), time.Time{}).Return(bookings, nil).Once()
+		mockRepo.On("ClientNames", ctx, []uint{2, 3}).Return(map[uint]string{2: "Maria, da Silva"}, nil).Once()
+
+		calendar, err := bookingService.ProfessionalCalendar(ctx, 1, token)
+
+		require.NoError(t, err)
+		body := string(calendar)
+		assert.Contains(t, body, "BEGIN:VCALENDAR\r\n")
+		assert.Contains(t, body, "UID:booking-42@1mao\r\n")
+		assert.Contains(t, body, "DTSTART:20300510T130000Z\r\n")
+		assert.Contains(t, body, "SUMMARY:Atendimento: Maria\\, da Silva\r\n")
+		assert.Contains(t, body, "SUMMARY:Atendimento: cliente #3\r\n")
+		assert.Contains(t, body, "STATUS:CANCELLED\r\n")
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - wrong token", func(t *testing.T) {
+		mockRepo.On("GetCalendarToken", ctx, uint(1)).Return(saved, nil).Once()
+
+		_, err := bookingService.ProfessionalCalendar(ctx, 1, "not-the-token")
+
+		assert.Equal(t, domain.ErrInvalidCalendarToken, err)
+	})
+}
+
+func TestBookingService_Waitlist(t *testing.T) {
+	ctx := context.Background()
+	start := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+
+	t.Run("Success - join", func(t *testing.T) {
+		mockRepo := new(MockBookingRepository)
+		bookingService := service.NewBookingService(mockRepo, nil, nil, nil, nil)
+		mockRepo.On("EmailVerified", ctx, domain.RoleClient, uint(2)).Return(true, nil).Once()
+		mockRepo.On("ProfessionalServiceArea", ctx, uint(1)).Return(&geo.ServiceArea{}, nil).Once()
+		mockRepo.On("CreateWaitlistEntry", ctx, mock.MatchedBy(func(e *domain.WaitlistEntry) bool {
+			return e.ClientID == 2 && e.ProfessionalID == 1 && e.Status == domain.WaitlistWaiting
+		})).Return(nil).Once()
+
+		entry, err := bookingService.JoinWaitlist(ctx, 2, &service.WaitlistRequest{
+			ProfessionalID: 1,
+			StartTime:      start,
+			EndTime:        start.Add(8 * time.Hour),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.WaitlistWaiting, entry.Status)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - range already over", func(t *testing.T) {
+		bookingService := service.NewBookingService(new(MockBookingRepository), nil, nil, nil, nil)
+
+		_, err := bookingService.JoinWaitlist(ctx, 2, &service.WaitlistRequest{
+			ProfessionalID: 1,
+			StartTime:      time.Now().Add(-2 * time.Hour),
+			EndTime:        time.Now().Add(-time.Hour),
+		})
+
+		assert.Equal(t, domain.ErrInvalidWaitlistEntry, err)
+	})
+
+	t.Run("Success - cancellation offers slot to waitlist", func(t *testing.T) {
+		mockRepo := new(MockBookingRepository)
+		mockNotifier := new(MockNotifier)
+		bookingService := service.NewBookingService(mockRepo, nil, mockNotifier, nil, nil)
+		clientCtx := domain.WithActor(ctx, domain.Actor{ID: 2, Role: domain.RoleClient})
+		serviceID := uint(6)
+		booked := &domain.Booking{ID: 4, ProfessionalID: 1, ClientID: 2, StartTime: start, EndTime: start.Add(time.Hour), Status: domain.StatusConfirmed,
+			ServiceID: &serviceID, PriceCents: 12000, Currency: "BRL"}
+		cancelled := *booked
+		cancelled.Status = domain.StatusCancelled
+
+		mockRepo.On("GetByID", clientCtx, uint(4)).Return(booked, nil).Once()
+		mockRepo.On("UpdateStatus", clientCtx, uint(4), domain.StatusCancelled, "").Return(&cancelled, nil).Once()
+		// A oferta leva o serviço e o preço do agendamento cancelado
+		mockRepo.On("OfferWaitlistSlot", clientCtx, domain.WaitlistOffer{
+			ProfessionalID: 1, Start: booked.StartTime, End: booked.EndTime, ServiceID: &serviceID, PriceCents: 12000, Currency: "BRL",
+		}, mock.MatchedBy(func(hold time.Time) bool {
+			return hold.After(time.Now()) && !hold.After(time.Now().Add(domain.WaitlistHoldDuration))
+		})).Return(&domain.WaitlistEntry{ID: 8, ProfessionalID: 1, ClientID: 3, Status: domain.WaitlistOffered}, nil).Once()
+		mockRepo.On("GetCancellationPolicy", clientCtx, uint(1)).Return(domain.DefaultCancellationPolicy(1), nil).Once()
+		mockNotifier.On("SendNotification", mock.MatchedBy(func(n notification.Notification) bool {
+			return n.Type == "waitlist_offer" && n.ReceiverType == "client" && n.ReceiverID == 3 && n.ID == 8
+		})).Once()
+
+		_, err := bookingService.CancelBooking(clientCtx, 4, domain.CancelOccurrence, "")
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockNotifier.AssertExpectations(t)
+	})
+
+	t.Run("Success - accept offer", func(t *testing.T) {
+		mockRepo := new(MockBookingRepository)
+		mockNotifier := new(MockNotifier)
+		bookingService := service.NewBookingService(mockRepo, nil, mockNotifier, nil, nil)
+
+		mockRepo.On("GetWaitlistEntry", ctx, uint(8)).Return(&domain.WaitlistEntry{ID: 8, ProfessionalID: 1, ClientID: 3, Status: domain.WaitlistOffered}, nil).Once()
+		mockRepo.On("EmailVerified", ctx, domain.RoleClient, uint(3)).Return(true, nil).Once()
+		mockRepo.On("AcceptWaitlistOffer", ctx, uint(8), mock.AnythingOfType("time.Time")).
+			Return(&domain.Booking{ID: 10, ProfessionalID: 1, ClientID: 3, StartTime: start, EndTime: start.Add(time.Hour), Status: domain.StatusPending}, nil).Once()
+		mockNotifier.On("SendNotification", mock.MatchedBy(func(n notification.Notification) bool {
+			return n.ReceiverType == "professional" && n.ReceiverID == 1 && n.ID == 10
+		})).Once()
+
+		booking, err := bookingService.AcceptWaitlistOffer(ctx, 3, 8)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(10), booking.ID)
+		mockRepo.AssertExpectations(t)
+		mockNotifier.AssertExpectations(t)
+	})
+
+	t.Run("Error - accept offer of another client", func(t *testing.T) {
+		mockRepo := new(MockBookingRepository)
+		bookingService := service.NewBookingService(mockRepo, nil, nil, nil, nil)
+		mockRepo.On("GetWaitlistEntry", ctx, uint(8)).Return(&domain.WaitlistEntry{ID: 8, ClientID: 3}, nil).Once()
+
+		_, err := bookingService.AcceptWaitlistOffer(ctx, 4, 8)
+
+		assert.Equal(t, domain.ErrForbidden, err)
+		mockRepo.AssertNotCalled(t, "AcceptWaitlistOffer", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success - expired hold goes to next client", func(t *testing.T) {
+		mockRepo := new(MockBookingRepository)
+		mockNotifier := new(MockNotifier)
+		bookingService := service.NewBookingService(mockRepo, nil, mockNotifier, nil, nil)
+		offeredEnd := start.Add(time.Hour)
+		expired := &domain.WaitlistEntry{ID: 8, ProfessionalID: 1, ClientID: 3, Status: domain.WaitlistExpired, OfferedStart: &start, OfferedEnd: &offeredEnd,
+			OfferedPriceCents: 12000, OfferedCurrency: "BRL"}
+
+		mockRepo.On("ExpireWaitlistHolds", ctx, mock.AnythingOfType("time.Time")).Return([]*domain.WaitlistEntry{expired}, nil).Once()
+		mockRepo.On("OfferWaitlistSlot", ctx, domain.WaitlistOffer{ProfessionalID: 1, Start: start, End: offeredEnd, PriceCents: 12000, Currency: "BRL"}, mock.AnythingOfType("time.Time")).
+			Return(&domain.WaitlistEntry{ID: 9, ProfessionalID: 1, ClientID: 5, Status: domain.WaitlistOffered}, nil).Once()
+		mockNotifier.On("SendNotification", mock.MatchedBy(func(n notification.Notification) bool {
+			return n.Type == "waitlist_offer_expired" && n.ReceiverID == 3
+		})).Once()
+		mockNotifier.On("SendNotification", mock.MatchedBy(func(n notification.Notification) bool {
+			return n.Type == "waitlist_offer" && n.ReceiverID == 5
+		})).Once()
+
+		count, err := bookingService.ExpireWaitlistHolds(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+		mockRepo.AssertExpectations(t)
+		mockNotifier.AssertExpectations(t)
+	})
+}
+
+func TestBookingService_Reviews(t *testing.T) {
+	ctx := context.Background()
+	completed := &domain.Booking{ID: 3, ProfessionalID: 1, ClientID: 2, Status: domain.StatusCompleted}
+
+	t.Run("Success - client reviews completed booking", func(t *testing.T) {
+		mockRepo := new(MockBookingRepository)
+		mockNotifier := new(MockNotifier)
+		bookingService := service.NewBookingService(mockRepo, nil, mockNotifier, nil, nil)
+
+		mockRepo.On("GetByID", ctx, uint(3)).Return(completed, nil).Once()
+		mockRepo.On("CreateReview", ctx, mock.MatchedBy(func(r *domain.Review) bool {
+			return r.BookingID == 3 && r.ProfessionalID == 1 && r.ClientID == 2 && r.Rating == 4
+		})).Return(nil).Once()
+		mockNotifier.On("SendNotification", mock.MatchedBy(func(n notification.Notification) bool {
+			return n.Type == "review_created" && n.ReceiverType == "professional" && n.ReceiverID == 1
+		})).Once()
+
+		review, err := bookingService.CreateReview(ctx, 2, 3, &service.ReviewRequest{Rating: 4, Comment: "Muito bom"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Muito bom", review.Comment)
+		mockRepo.AssertExpectations(t)
+		mockNotifier.AssertExpectations(t)
+	})
+
+	t.Run("Success - review invalidates the cached profile", func(t *testing.T) {
+		mockRepo := new(MockBookingRepository)
+		mockCache := new(MockProfessionalCache)
+		bookingService := service.NewBookingService(mockRepo, nil, nil, nil, mockCache)
+
+		mockRepo.On("GetByID", ctx, uint(3)).Return(completed, nil).Once()
+		mockRepo.On("CreateReview", ctx, mock.AnythingOfType("*domain.Review")).Return(nil).Once()
+		mockCache.On("InvalidateProfessional", uint(1)).Once()
+
+		_, err := bookingService.CreateReview(ctx, 2, 3, &service.ReviewRequest{Rating: 5})
+
+		assert.NoError(t, err)
+		mockCache.AssertExpectations(t)
+	})
+
+	t.Run("Error - failed review keeps the cache", func(t *testing.T) {
+		mockRepo := new(MockBookingRepository)
+		mockCache := new(MockProfessionalCache)
+		bookingService := service.NewBookingService(mockRepo, nil, nil, nil, mockCache)
+
+		mockRepo.On("GetByID", ctx, uint(3)).Return(completed, nil).Once()
+		mockRepo.On("CreateReview", ctx, mock.AnythingOfType("*domain.Review")).Return(domain.ErrReviewExists).Once()
+
+		_, err := bookingService.CreateReview(ctx, 2, 3, &service.ReviewRequest{Rating: 5})
+
+		assert.Equal(t, domain.ErrReviewExists, err)
+		mockCache.AssertNotCalled(t, "InvalidateProfessional", mock.Anything)
+	})
+
+	t.Run("Error - booking not completed", func(t *testing.T) {
+		mockRepo := new(MockBookingRepository)
+		bookingService := service.NewBookingService(mockRepo, nil, nil, nil, nil)
+		confirmed := *completed
+		confirmed.Status = domain.StatusConfirmed
+		mockRepo.On("GetByID", ctx, uint(3)).Return(&confirmed, nil).Once()
+
+		_, err := bookingService.CreateReview(ctx, 2, 3, &service.ReviewRequest{Rating: 5})
+
+		assert.Equal(t, domain.ErrBookingNotReviewable, err)
+	})
+
+	t.Run("Error - booking of another client", func(t *testing.T) {
+		mockRepo := new(MockBookingRepository)
+		bookingService := service.NewBookingService(mockRepo, nil, nil, nil, nil)
+		mockRepo.On("GetByID", ctx, uint(3)).Return(completed, nil).Once()
+
+		_, err := bookingService.CreateReview(ctx, 9, 3, &service.ReviewRequest{Rating: 5})
+
+		assert.Equal(t, domain.ErrForbidden, err)
+	})
+
+	t.Run("Error - rating out of range", func(t *testing.T) {
+		mockRepo := new(MockBookingRepository)
+		bookingService := service.NewBookingService(mockRepo, nil, nil, nil, nil)
+		mockRepo.On("GetByID", ctx, uint(3)).Return(completed, nil).Once()
+
+		_, err := bookingService.CreateReview(ctx, 2, 3, &service.ReviewRequest{Rating: 6})
+
+		assert.Equal(t, domain.ErrInvalidReview, err)
+		mockRepo.AssertNotCalled(t, "CreateReview", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Error - reply by another professional", func(t *testing.T) {
+		mockRepo := new(MockBookingRepository)
+		bookingService := service.NewBookingService(mockRepo, nil, nil, nil, nil)
+		mockRepo.On("GetReview", ctx, uint(7)).Return(&domain.Review{ID: 7, ProfessionalID: 1, ClientID: 2}, nil).Once()
+
+		_, err := bookingService.ReplyToReview(ctx, 5, 7, "Obrigado")
+
+		assert.Equal(t, domain.ErrForbidden, err)
+		mockRepo.AssertNotCalled(t, "ReplyToReview", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success - paginated list", func(t *testing.T) {
+		mockRepo := new(MockBookingRepository)
+		bookingService := service.NewBookingService(mockRepo, nil, nil, nil, nil)
+		now := time.Now()
+		reviews := []*domain.Review{
+			{ID: 9, ProfessionalID: 1, CreatedAt: now},
+			{ID: 8, ProfessionalID: 1, CreatedAt: now.Add(-time.Hour)},
+		}
+		mockRepo.On("ListReviews", ctx, uint(1), (*domain.ReviewCursor)(nil), 2).Return(reviews, nil).Once()
+
+		first, err := bookingService.ListReviews(ctx, 1, "", 1)
+		require.NoError(t, err)
+		require.Len(t, first.Reviews, 1)
+		require.NotEmpty(t, first.NextCursor)
+
+		mockRepo.On("ListReviews", ctx, uint(1), mock.MatchedBy(func(c *domain.ReviewCursor) bool {
+			return c != nil && c.ID == 9 && c.CreatedAt.Equal(now)
+		}), 2).Return(reviews[1:], nil).Once()
+
+		second, err := bookingService.ListReviews(ctx, 1, first.NextCursor, 1)
+		require.NoError(t, err)
+		assert.Len(t, second.Reviews, 1)
+		assert.Empty(t, second.NextCursor)
+		mockRepo.AssertExpectations(t)
+	})
+}
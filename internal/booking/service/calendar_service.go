@@ -0,0 +1,119 @@
+package service
+
+import (
+	"1mao/internal/booking/domain"
+	"1mao/internal/booking/ics"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// calendarLookback é quanto do passado entra no feed do profissional
+const calendarLookback = 90 * 24 * time.Hour
+
+// RotateCalendarToken gera um novo token para o feed iCalendar do
+// profissional, invalidando o anterior. O token só é devolvido aqui.
+func (s *bookingService) RotateCalendarToken(ctx context.Context, professionalID uint) (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(raw)
+
+	err := s.bookingRepo.SaveCalendarToken(ctx, &domain.CalendarToken{
+		ProfessionalID: professionalID,
+		TokenHash:      hashCalendarToken(token),
+		CreatedAt:      time.Now(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// ProfessionalCalendar gera o feed iCalendar do profissional
+func (s *bookingService) ProfessionalCalendar(ctx context.Context, professionalID uint, token string) ([]byte, error) {
+	stored, err := s.bookingRepo.GetCalendarToken(ctx, professionalID)
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(stored.TokenHash), []byte(hashCalendarToken(token))) != 1 {
+		return nil, domain.ErrInvalidCalendarToken
+	}
+
+	bookings, err := s.bookingRepo.ListByProfessional(ctx, professionalID, time.Now().Add(-calendarLookback), time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint, 0, len(bookings))
+	for _, b := range bookings {
+		ids = append(ids, b.ClientID)
+	}
+	names, err := s.bookingRepo.ClientNames(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]ics.Event, 0, len(bookings))
+	for _, b := range bookings {
+		events = append(events, toICSEvent(b, "Atendimento: "+displayName(names[b.ClientID], "cliente", b.ClientID)))
+	}
+	return ics.Calendar("1Mao - Agenda", events), nil
+}
+
+// BookingCalendar gera o arquivo .ics de um único agendamento
+func (s *bookingService) BookingCalendar(ctx context.Context, id uint) ([]byte, error) {
+	booking, err := s.authorizedBooking(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := s.bookingRepo.ProfessionalNames(ctx, []uint{booking.ProfessionalID})
+	if err != nil {
+		return nil, err
+	}
+
+	summary := "Atendimento com " + displayName(names[booking.ProfessionalID], "profissional", booking.ProfessionalID)
+	return ics.Calendar("", []ics.Event{toICSEvent(booking, summary)}), nil
+}
+
+func hashCalendarToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func displayName(name, fallback string, id uint) string {
+	if name != "" {
+		return name
+	}
+	return fmt.Sprintf("%s #%d", fallback, id)
+}
+
+// toICSEvent converte o agendamento num VEVENT com UID estável por ID
+func toICSEvent(b *domain.Booking, summary string) ics.Event {
+	return ics.Event{
+		UID:          fmt.Sprintf("booking-%d@1mao", b.ID),
+		Start:        b.StartTime,
+		End:          b.EndTime,
+		Summary:      summary,
+		Description:  fmt.Sprintf("Agendamento #%d - status: %s", b.ID, b.Status),
+		Status:       toICSStatus(b.Status),
+		LastModified: b.UpdatedAt,
+	}
+}
+
+func toICSStatus(status domain.BookingStatus) ics.Status {
+	switch status {
+	case domain.StatusPending:
+		return ics.StatusTentative
+	case domain.StatusConfirmed, domain.StatusInProgress, domain.StatusCompleted:
+		return ics.StatusConfirmed
+	default:
+		return ics.StatusCancelled
+	}
+}
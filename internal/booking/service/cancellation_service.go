@@ -0,0 +1,116 @@
+package service
+
+import (
+	"1mao/internal/booking/domain"
+	payment "1mao/internal/payment/domain"
+	"context"
+	"log"
+	"strconv"
+	"time"
+)
+
+// PaymentSettler acerta o pagamento de um agendamento cancelado (reembolso
+// ou cobrança da multa). Implementado por payment/service.PaymentService.
+type PaymentSettler interface {
+	SettleCancellation(clientID string, bookingID string, fee int64) (*payment.Transaction, error)
+}
+
+// CancellationPolicyRequest define o payload da política de cancelamento
+// @Model CancellationPolicyRequest
+type CancellationPolicyRequest struct {
+	FreeCancellationHours int `json:"free_cancellation_hours" example:"24"`
+	LateCancellationFee   int `json:"late_cancellation_fee_percent" example:"50"`
+	NoShowFee             int `json:"no_show_fee_percent" example:"100"`
+}
+
+// CancellationResponse define a resposta de um cancelamento com a multa aplicada
+// @Model CancellationResponse
+type CancellationResponse struct {
+	Bookings []*BookingResponse `json:"bookings"`
+	FeeCents int64              `json:"fee_cents"`
+	Currency string             `json:"currency,omitempty"`
+}
+
+func (s *bookingService) GetCancellationPolicy(ctx context.Context, professionalID uint) (*domain.CancellationPolicy, error) {
+	return s.bookingRepo.GetCancellationPolicy(ctx, professionalID)
+}
+
+func (s *bookingService) UpdateCancellationPolicy(ctx context.Context, professionalID uint, req *CancellationPolicyRequest) (*domain.CancellationPolicy, error) {
+	policy := &domain.CancellationPolicy{
+		ProfessionalID:        professionalID,
+		FreeCancellationHours: req.FreeCancellationHours,
+		LateCancellationFee:   req.LateCancellationFee,
+		NoShowFee:             req.NoShowFee,
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.bookingRepo.SaveCancellationPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// settleCancellation aplica a política do profissional aos agendamentos
+// cancelados e acerta o pagamento de cada um. Cancelamentos feitos pelo
+// próprio profissional não geram multa.
+func (s *bookingService) settleCancellation(ctx context.Context, bookings []*domain.Booking, now time.Time) (*CancellationResponse, error) {
+	response := &CancellationResponse{Bookings: s.toListResponse(bookings)}
+	if len(bookings) == 0 {
+		response.Bookings = []*BookingResponse{}
+		return response, nil
+	}
+
+	policy, err := s.bookingRepo.GetCancellationPolicy(ctx, bookings[0].ProfessionalID)
+	if err != nil {
+		return nil, err
+	}
+	waived := domain.ActorFromContext(ctx).Role == domain.RoleProfessional
+
+	for _, b := range bookings {
+		var fee int64
+		if !waived {
+			fee = policy.CancellationFee(b, now)
+		}
+		response.FeeCents += fee
+		response.Currency = b.Currency
+
+		if s.payments == nil {
+			continue
+		}
+		// O cancelamento já foi gravado; falha no gateway não o desfaz
+		_, err := s.payments.SettleCancellation(
+			strconv.FormatUint(uint64(b.ClientID), 10),
+			strconv.FormatUint(uint64(b.ID), 10),
+			fee,
+		)
+		if err != nil {
+			log.Printf("Erro ao acertar pagamento do agendamento %d: %v", b.ID, err)
+		}
+	}
+	return response, nil
+}
+
+// chargeNoShow cobra a multa de não comparecimento definida na política. O
+// status já foi gravado: falhas são registradas no log e não desfazem a
+// transição, como em settleCancellation.
+func (s *bookingService) chargeNoShow(ctx context.Context, b *domain.Booking) {
+	if s.payments == nil {
+		return
+	}
+	policy, err := s.bookingRepo.GetCancellationPolicy(ctx, b.ProfessionalID)
+	if err != nil {
+		log.Printf("Erro ao buscar política para cobrar não comparecimento do agendamento %d: %v", b.ID, err)
+		return
+	}
+
+	_, err = s.payments.SettleCancellation(
+		strconv.FormatUint(uint64(b.ClientID), 10),
+		strconv.FormatUint(uint64(b.ID), 10),
+		policy.NoShowCharge(b),
+	)
+	if err != nil {
+		log.Printf("Erro ao cobrar não comparecimento do agendamento %d: %v", b.ID, err)
+	}
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"1mao/internal/booking/domain"
+	"1mao/pkg/email"
+	"1mao/pkg/timezone"
+	"context"
+	"fmt"
+)
+
+// sendConfirmationEmail avisa o cliente por e-mail, em segundo plano, que o
+// profissional confirmou o agendamento, com os horários no fuso do cliente.
+// Falhas só são registradas: a confirmação já foi gravada.
+func (s *bookingService) sendConfirmationEmail(ctx context.Context, b *domain.Booking) {
+	if s.mailer == nil {
+		return
+	}
+	email.SendAsync(ctx, fmt.Sprintf("confirmação do agendamento %d", b.ID), func(ctx context.Context) error {
+		return s.confirmationEmail(ctx, b)
+	})
+}
+
+func (s *bookingService) confirmationEmail(ctx context.Context, b *domain.Booking) error {
+	contact, err := s.bookingRepo.ClientContact(ctx, b.ClientID)
+	if err != nil || contact == nil {
+		return err
+	}
+	names, err := s.bookingRepo.ProfessionalNames(ctx, []uint{b.ProfessionalID})
+	if err != nil {
+		return err
+	}
+	loc, err := timezone.Load(contact.TimeZone)
+	if err != nil {
+		return err
+	}
+
+	return email.SendTemplate(ctx, s.mailer, contact.Email, contact.Locale, email.TemplateBookingConfirmed, email.BookingConfirmedData{
+		Name:             contact.Name,
+		ProfessionalName: displayName(names[b.ProfessionalID], "profissional", b.ProfessionalID),
+		BookingID:        b.ID,
+		Start:            b.StartTime.In(loc),
+		End:              b.EndTime.In(loc),
+		Address:          b.ServiceAddress.Line(),
+		PriceCents:       b.PriceCents,
+		Currency:         b.Currency,
+	})
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"1mao/internal/booking/domain"
+	notification "1mao/internal/notification/domain"
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+// Notifier envia notificações aos participantes do agendamento.
+// Implementado por notification/service.NotificationService.
+type Notifier interface {
+	SendNotification(notification notification.Notification)
+}
+
+// ExpirePendingBookings expira os agendamentos pendentes há mais de ttl,
+// liberando os horários, e avisa cliente e profissional
+func (s *bookingService) ExpirePendingBookings(ctx context.Context, ttl time.Duration) (int, error) {
+	ctx = domain.WithActor(ctx, domain.Actor{Role: domain.RoleSystem})
+	expired, err := s.bookingRepo.ExpirePending(ctx, time.Now().Add(-ttl))
+	if err != nil {
+		return 0, err
+	}
+
+	for _, b := range expired {
+		s.notifyParticipants(b, "booking_expired", fmt.Sprintf(
+			"O agendamento #%d de %s expirou por falta de confirmação",
+			b.ID, b.StartTime.Format("02/01/2006 15:04"),
+		))
+	}
+	s.offerFreedSlots(ctx, expired)
+	return len(expired), nil
+}
+
+// notifyParticipants avisa o cliente e o profissional do agendamento
+func (s *bookingService) notifyParticipants(b *domain.Booking, kind, content string) {
+	s.notify(b, "client", b.ClientID, kind, content)
+	s.notify(b, "professional", b.ProfessionalID, kind, content)
+}
+
+func (s *bookingService) notify(b *domain.Booking, receiverType string, receiverID uint, kind, content string) {
+	s.send(kind, b.ID, receiverType, receiverID, content)
+}
+
+// send entrega a notificação pelo hub; id identifica o recurso do tipo kind
+func (s *bookingService) send(kind string, id uint, receiverType string, receiverID uint, content string) {
+	if s.notifier == nil {
+		return
+	}
+	s.notifier.SendNotification(notification.Notification{
+		Type:         kind,
+		ID:           int(id),
+		ReceiverID:   int(receiverID),
+		ReceiverType: receiverType,
+		Content:      content,
+	})
+}
+
+// ExpiryScheduler executa periodicamente a expiração de agendamentos pendentes
+// e das reservas da lista de espera
+type ExpiryScheduler struct {
+	bookings BookingService
+	ttl      time.Duration
+	interval time.Duration
+}
+
+func NewExpiryScheduler(bookings BookingService, ttl, interval time.Duration) *ExpiryScheduler {
+	return &ExpiryScheduler{bookings: bookings, ttl: ttl, interval: interval}
+}
+
+// Run bloqueia até ctx ser cancelado, expirando pendentes a cada intervalo
+func (s *ExpiryScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.runOnce(ctx)
+		select {
+		case <-ctx.Done():
+			log.Println("agendador de expiração finalizado")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *ExpiryScheduler) runOnce(ctx context.Context) {
+	count, err := s.bookings.ExpirePendingBookings(ctx, s.ttl)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("erro ao expirar agendamentos pendentes: %v", err)
+		}
+		return
+	}
+	if count > 0 {
+		log.Printf("%d agendamento(s) pendente(s) expirado(s)", count)
+	}
+
+	holds, err := s.bookings.ExpireWaitlistHolds(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("erro ao expirar reservas da lista de espera: %v", err)
+		}
+		return
+	}
+	if holds > 0 {
+		log.Printf("%d reserva(s) da lista de espera expirada(s)", holds)
+	}
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"1mao/internal/booking/domain"
+	"context"
+	"fmt"
+	"time"
+)
+
+// ProfessionalCache descarta o perfil em cache de um profissional cuja nota
+// mudou. Implementado por professional/service.ProfileCache.
+type ProfessionalCache interface {
+	InvalidateProfessional(id uint)
+}
+
+// ReviewRequest define o payload da avaliação de um agendamento
+// @Model ReviewRequest
+type ReviewRequest struct {
+	Rating  int    `json:"rating" example:"5"` // 1 a 5
+	Comment string `json:"comment" example:"Pontual e caprichoso"`
+}
+
+// ReviewPage é uma página das avaliações de um profissional
+// @Model ReviewPage
+type ReviewPage struct {
+	Reviews []*domain.Review `json:"reviews"`
+	// Vazio quando não há mais páginas
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// CreateReview registra a avaliação do cliente sobre um agendamento dele já concluído
+func (s *bookingService) CreateReview(ctx context.Context, clientID, bookingID uint, req *ReviewRequest) (*domain.Review, error) {
+	booking, err := s.bookingRepo.GetByID(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if booking.ClientID != clientID {
+		return nil, domain.ErrForbidden
+	}
+	if booking.Status != domain.StatusCompleted {
+		return nil, domain.ErrBookingNotReviewable
+	}
+
+	now := time.Now()
+	review := &domain.Review{
+		BookingID:      booking.ID,
+		ProfessionalID: booking.ProfessionalID,
+		ClientID:       clientID,
+		Rating:         req.Rating,
+		Comment:        req.Comment,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if err := review.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.bookingRepo.CreateReview(ctx, review); err != nil {
+		return nil, err
+	}
+	// A nota e a contagem de avaliações já foram gravadas
+	if s.professionals != nil {
+		s.professionals.InvalidateProfessional(review.ProfessionalID)
+	}
+
+	s.send("review_created", review.ID, "professional", review.ProfessionalID, fmt.Sprintf(
+		"Você recebeu uma avaliação %d/5 pelo agendamento #%d", review.Rating, booking.ID,
+	))
+	return review, nil
+}
+
+// ReplyToReview registra a resposta do profissional avaliado
+func (s *bookingService) ReplyToReview(ctx context.Context, professionalID, reviewID uint, reply string) (*domain.Review, error) {
+	review, err := s.bookingRepo.GetReview(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if review.ProfessionalID != professionalID {
+		return nil, domain.ErrForbidden
+	}
+	if err := domain.ValidateReply(reply); err != nil {
+		return nil, err
+	}
+
+	review, err = s.bookingRepo.ReplyToReview(ctx, reviewID, reply)
+	if err != nil {
+		return nil, err
+	}
+
+	s.send("review_replied", review.ID, "client", review.ClientID, "O profissional respondeu a sua avaliação")
+	return review, nil
+}
+
+// ListReviews pagina as avaliações do profissional, mais recentes primeiro
+func (s *bookingService) ListReviews(ctx context.Context, professionalID uint, cursor string, limit int) (*ReviewPage, error) {
+	if limit == 0 {
+		limit = domain.DefaultPageSize
+	}
+	if limit < 0 || limit > domain.MaxPageSize {
+		return nil, domain.ErrInvalidListQuery
+	}
+
+	var after *domain.ReviewCursor
+	if cursor != "" {
+		var err error
+		if after, err = domain.DecodeReviewCursor(cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	reviews, err := s.bookingRepo.ListReviews(ctx, professionalID, after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &ReviewPage{Reviews: reviews}
+	if len(reviews) > limit {
+		page.Reviews = reviews[:limit]
+		page.NextCursor = domain.CursorAfterReview(page.Reviews[limit-1]).Encode()
+	}
+	if page.Reviews == nil {
+		page.Reviews = []*domain.Review{}
+	}
+	return page, nil
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"1mao/internal/booking/domain"
+	"1mao/internal/booking/repository"
+	"context"
+)
+
+// CreateRecurringBookingRequest define o payload para criação de uma série de agendamentos
+// @Model CreateRecurringBookingRequest
+type CreateRecurringBookingRequest struct {
+	CreateBookingRequest
+	Recurrence domain.Recurrence `json:"recurrence"`
+}
+
+// SeriesResponse define a resposta de uma série de agendamentos
+// @Model SeriesResponse
+type SeriesResponse struct {
+	Series   *domain.BookingSeries `json:"series"`
+	Bookings []*BookingResponse    `json:"bookings"`
+}
+
+func (s *bookingService) CreateRecurringBooking(ctx context.Context, req *CreateRecurringBookingRequest) (*SeriesResponse, error) {
+	first, err := s.prepareBooking(ctx, &req.CreateBookingRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := s.professionalLocation(ctx, first.ProfessionalID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A recorrência segue o relógio do profissional, atravessando mudanças de horário de verão
+	slots, err := req.Recurrence.Occurrences(first.StartTime.In(loc), first.EndTime.In(loc))
+	if err != nil {
+		return nil, err
+	}
+
+	windows, err := s.bookingRepo.ListAvailability(ctx, first.ProfessionalID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Horário de atendimento é checado aqui; sobreposição fica com o repositório
+	var conflicts []domain.OccurrenceConflict
+	occurrences := make([]*repository.CreateBookingRequest, 0, len(slots))
+	for _, slot := range slots {
+		if !domain.WithinAvailability(windows, slot.Start, slot.End) {
+			conflicts = append(conflicts, domain.OccurrenceConflict{
+				StartTime: slot.Start,
+				EndTime:   slot.End,
+				Reason:    domain.ErrOutsideWorkingHours.Error(),
+			})
+			continue
+		}
+		occurrence := *first
+		occurrence.StartTime = slot.Start
+		occurrence.EndTime = slot.End
+		occurrences = append(occurrences, &occurrence)
+	}
+	if len(conflicts) > 0 {
+		return nil, &domain.SeriesConflictError{Conflicts: conflicts}
+	}
+
+	series := &domain.BookingSeries{
+		ProfessionalID: first.ProfessionalID,
+		ClientID:       first.ClientID,
+		ServiceID:      first.ServiceID,
+		Frequency:      req.Recurrence.Frequency,
+		Count:          req.Recurrence.Count,
+		Until:          req.Recurrence.Until,
+	}
+	bookings, err := s.bookingRepo.CreateSeries(ctx, series, occurrences)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SeriesResponse{Series: series, Bookings: s.toListResponse(bookings)}, nil
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"1mao/internal/booking/domain"
+	"1mao/pkg/geo"
+	"context"
+)
+
+// checkServiceArea valida o endereço do atendimento contra a região atendida
+// pelo profissional e devolve o endereço normalizado a ser gravado. Sem área
+// definida o endereço é opcional.
+func (s *bookingService) checkServiceArea(ctx context.Context, professionalID uint, address *geo.Address) (geo.Address, error) {
+	area, err := s.bookingRepo.ProfessionalServiceArea(ctx, professionalID)
+	if err != nil {
+		return geo.Address{}, err
+	}
+
+	if address == nil || address.IsZero() {
+		if area.Restricted() {
+			return geo.Address{}, domain.ErrServiceAddressRequired
+		}
+		return geo.Address{}, nil
+	}
+
+	normalized := *address
+	if err := normalized.Normalize(); err != nil {
+		return geo.Address{}, err
+	}
+	if !area.Covers(&normalized) {
+		return geo.Address{}, domain.ErrOutsideServiceArea
+	}
+	return normalized, nil
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"1mao/internal/booking/domain"
+	"context"
+	"fmt"
+	"time"
+)
+
+// TimeOffRequest define o payload para bloquear um período na agenda
+// @Model TimeOffRequest
+type TimeOffRequest struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	Reason    string    `json:"reason" example:"Férias"`
+	// Cancela (e avisa os clientes) os agendamentos ativos no período
+	Force bool `json:"force"`
+}
+
+// TimeOffResponse define a resposta da criação de uma folga
+// @Model TimeOffResponse
+type TimeOffResponse struct {
+	TimeOff           *domain.TimeOff    `json:"time_off"`
+	CancelledBookings []*BookingResponse `json:"cancelled_bookings"`
+}
+
+func (s *bookingService) ListTimeOff(ctx context.Context, professionalID uint, from, to time.Time) ([]*domain.TimeOff, error) {
+	timeOff, err := s.bookingRepo.ListTimeOff(ctx, professionalID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if timeOff == nil {
+		timeOff = []*domain.TimeOff{}
+	}
+	return timeOff, nil
+}
+
+// CreateTimeOff bloqueia o período na agenda do profissional. Com Force, os
+// agendamentos ativos no período são cancelados sem multa, o pagamento é
+// devolvido e os clientes são avisados.
+func (s *bookingService) CreateTimeOff(ctx context.Context, professionalID uint, req *TimeOffRequest) (*TimeOffResponse, error) {
+	timeOff := &domain.TimeOff{
+		ProfessionalID: professionalID,
+		StartTime:      req.StartTime,
+		EndTime:        req.EndTime,
+		Reason:         req.Reason,
+	}
+	if err := timeOff.Validate(); err != nil {
+		return nil, err
+	}
+
+	// O cancelamento é sempre do profissional, o que isenta o cliente de multa
+	ctx = domain.WithActor(ctx, domain.Actor{ID: professionalID, Role: domain.RoleProfessional})
+	cancelled, err := s.bookingRepo.CreateTimeOff(ctx, timeOff, req.Force)
+	if err != nil {
+		return nil, err
+	}
+
+	settled, err := s.settleCancellation(ctx, cancelled, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range cancelled {
+		s.notify(b, "client", b.ClientID, "booking_cancelled", fmt.Sprintf(
+			"O agendamento #%d de %s foi cancelado: o profissional estará indisponível",
+			b.ID, b.StartTime.Format("02/01/2006 15:04"),
+		))
+	}
+
+	return &TimeOffResponse{TimeOff: timeOff, CancelledBookings: settled.Bookings}, nil
+}
+
+func (s *bookingService) DeleteTimeOff(ctx context.Context, professionalID, id uint) error {
+	timeOff, err := s.bookingRepo.GetTimeOff(ctx, id)
+	if err != nil {
+		return err
+	}
+	if timeOff.ProfessionalID != professionalID {
+		return domain.ErrTimeOffNotFound
+	}
+	return s.bookingRepo.DeleteTimeOff(ctx, id)
+}
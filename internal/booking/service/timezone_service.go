@@ -0,0 +1,61 @@
+package service
+
+import (
+	"1mao/internal/booking/domain"
+	"1mao/pkg/timezone"
+	"context"
+	"time"
+)
+
+// professionalLocation carrega o fuso em que a agenda do profissional é avaliada
+func (s *bookingService) professionalLocation(ctx context.Context, professionalID uint) (*time.Location, error) {
+	name, err := s.bookingRepo.ProfessionalTimeZone(ctx, professionalID)
+	if err != nil {
+		return nil, err
+	}
+	return timezone.Load(name)
+}
+
+// professionalSchedule devolve as janelas de atendimento e o fuso do
+// profissional. Sem janelas cadastradas a agenda não tem restrição de
+// horário (domain.Unrestricted).
+func (s *bookingService) professionalSchedule(ctx context.Context, professionalID uint) ([]*domain.Availability, *time.Location, error) {
+	loc, err := s.professionalLocation(ctx, professionalID)
+	if err != nil {
+		return nil, nil, err
+	}
+	windows, err := s.bookingRepo.ListAvailability(ctx, professionalID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(windows) == 0 {
+		windows = domain.Unrestricted(professionalID)
+	}
+	return windows, loc, nil
+}
+
+// checkWorkingHours verifica se [start, end) cabe no horário de atendimento,
+// avaliado no relógio local do profissional
+func (s *bookingService) checkWorkingHours(ctx context.Context, professionalID uint, start, end time.Time) error {
+	windows, loc, err := s.professionalSchedule(ctx, professionalID)
+	if err != nil {
+		return err
+	}
+	if !domain.WithinAvailability(windows, start.In(loc), end.In(loc)) {
+		return domain.ErrOutsideWorkingHours
+	}
+	return nil
+}
+
+// viewerLocation resolve o fuso de exibição: o pedido explicitamente ou, na
+// falta dele, o do usuário dono da listagem
+func viewerLocation(ctx context.Context, override string, lookup func(context.Context, uint) (string, error), userID uint) (*time.Location, error) {
+	if override != "" {
+		return timezone.Load(override)
+	}
+	name, err := lookup(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return timezone.Load(name)
+}
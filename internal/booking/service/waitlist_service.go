@@ -0,0 +1,165 @@
+package service
+
+import (
+	"1mao/internal/booking/domain"
+	"1mao/pkg/geo"
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+// WaitlistRequest define o payload de inscrição na lista de espera
+// @Model WaitlistRequest
+type WaitlistRequest struct {
+	ProfessionalID uint      `json:"professional_id"`
+	StartTime      time.Time `json:"start_time"`
+	EndTime        time.Time `json:"end_time"`
+	// Endereço do atendimento; obrigatório quando o profissional limita a área atendida
+	ServiceAddress *geo.Address `json:"service_address,omitempty"`
+}
+
+// JoinWaitlist inscreve o cliente para ser avisado quando um horário do
+// profissional liberar dentro do intervalo pedido
+func (s *bookingService) JoinWaitlist(ctx context.Context, clientID uint, req *WaitlistRequest) (*domain.WaitlistEntry, error) {
+	now := time.Now()
+	entry := &domain.WaitlistEntry{
+		ProfessionalID: req.ProfessionalID,
+		ClientID:       clientID,
+		StartTime:      req.StartTime,
+		EndTime:        req.EndTime,
+		Status:         domain.WaitlistWaiting,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if err := entry.Validate(now); err != nil {
+		return nil, err
+	}
+	if err := s.requireVerifiedEmail(ctx, clientID); err != nil {
+		return nil, err
+	}
+	address, err := s.checkServiceArea(ctx, entry.ProfessionalID, req.ServiceAddress)
+	if err != nil {
+		return nil, err
+	}
+	entry.ServiceAddress = address
+	if err := s.bookingRepo.CreateWaitlistEntry(ctx, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (s *bookingService) ListWaitlist(ctx context.Context, clientID uint) ([]*domain.WaitlistEntry, error) {
+	entries, err := s.bookingRepo.ListWaitlistByClient(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if entries == nil {
+		entries = []*domain.WaitlistEntry{}
+	}
+	return entries, nil
+}
+
+// LeaveWaitlist tira o cliente da lista; um horário que estava reservado
+// para ele é oferecido ao próximo
+func (s *bookingService) LeaveWaitlist(ctx context.Context, clientID, id uint) error {
+	if _, err := s.ownedWaitlistEntry(ctx, clientID, id); err != nil {
+		return err
+	}
+
+	entry, err := s.bookingRepo.CancelWaitlistEntry(ctx, id)
+	if err != nil {
+		return err
+	}
+	if entry.OfferedStart != nil {
+		s.offerSlot(ctx, entry.Offer())
+	}
+	return nil
+}
+
+// AcceptWaitlistOffer cria o agendamento do horário oferecido enquanto a
+// reserva estiver valendo
+func (s *bookingService) AcceptWaitlistOffer(ctx context.Context, clientID, id uint) (*BookingResponse, error) {
+	if _, err := s.ownedWaitlistEntry(ctx, clientID, id); err != nil {
+		return nil, err
+	}
+	if err := s.requireVerifiedEmail(ctx, clientID); err != nil {
+		return nil, err
+	}
+
+	booking, err := s.bookingRepo.AcceptWaitlistOffer(ctx, id, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	s.notify(booking, "professional", booking.ProfessionalID, "booking_created", fmt.Sprintf(
+		"Novo agendamento #%d em %s vindo da lista de espera",
+		booking.ID, booking.StartTime.Format("02/01/2006 15:04"),
+	))
+	return s.toResponse(booking), nil
+}
+
+// ExpireWaitlistHolds encerra as ofertas não aceitas a tempo e repassa os
+// horários para os próximos da lista
+func (s *bookingService) ExpireWaitlistHolds(ctx context.Context) (int, error) {
+	expired, err := s.bookingRepo.ExpireWaitlistHolds(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	for _, entry := range expired {
+		s.send("waitlist_offer_expired", entry.ID, "client", entry.ClientID, fmt.Sprintf(
+			"A reserva do horário de %s expirou", entry.OfferedStart.Format("02/01/2006 15:04"),
+		))
+		s.offerSlot(ctx, entry.Offer())
+	}
+	return len(expired), nil
+}
+
+func (s *bookingService) ownedWaitlistEntry(ctx context.Context, clientID, id uint) (*domain.WaitlistEntry, error) {
+	entry, err := s.bookingRepo.GetWaitlistEntry(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if entry.ClientID != clientID {
+		return nil, domain.ErrForbidden
+	}
+	return entry, nil
+}
+
+// offerFreedSlots oferece à lista de espera os horários dos agendamentos
+// cancelados ou expirados
+func (s *bookingService) offerFreedSlots(ctx context.Context, bookings []*domain.Booking) {
+	for _, b := range bookings {
+		s.offerSlot(ctx, domain.FreedSlot(b))
+	}
+}
+
+// offerSlot reserva o horário para o primeiro cliente da lista e o avisa pelo
+// hub. Falhas só são registradas: a operação que liberou o horário já foi gravada.
+func (s *bookingService) offerSlot(ctx context.Context, offer domain.WaitlistOffer) {
+	now := time.Now()
+	start := offer.Start
+	if !start.After(now) {
+		return
+	}
+	// A reserva não passa do início do atendimento
+	holdUntil := now.Add(domain.WaitlistHoldDuration)
+	if start.Before(holdUntil) {
+		holdUntil = start
+	}
+
+	entry, err := s.bookingRepo.OfferWaitlistSlot(ctx, offer, holdUntil)
+	if err != nil {
+		log.Printf("erro ao oferecer horário à lista de espera do profissional %d: %v", offer.ProfessionalID, err)
+		return
+	}
+	if entry == nil {
+		return
+	}
+
+	s.send("waitlist_offer", entry.ID, "client", entry.ClientID, fmt.Sprintf(
+		"Um horário liberou em %s. Ele fica reservado para você até %s",
+		start.Format("02/01/2006 15:04"), holdUntil.Format("15:04"),
+	))
+}
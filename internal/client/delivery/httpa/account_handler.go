@@ -0,0 +1,403 @@
+package httpa
+
+import (
+	"1mao/internal/client/domain"
+	"1mao/internal/client/service"
+	"1mao/internal/middleware"
+	"1mao/pkg/auth"
+	"1mao/pkg/geo"
+	"1mao/pkg/timezone"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/gorilla/mux"
+)
+
+// DeleteAccountRequest confirma a exclusão da conta
+//
+//	@Description	Senha atual para confirmar a exclusão
+type DeleteAccountRequest struct {
+	Password string `json:"password" example:"senhaSegura123"`
+}
+
+// ConfirmEmailRequest traz o token enviado para o novo e-mail
+//
+//	@Description	Token de confirmação da troca de e-mail
+type ConfirmEmailRequest struct {
+	Token string `json:"token" example:"3f1c2a9e-8b7d-4c6f-9a0e-1d2b3c4d5e6f"`
+}
+
+// UpdateProfile godoc
+//
+//	@Summary		Atualizar perfil do cliente
+//	@Description	Atualiza nome, telefone e fuso do cliente autenticado. Campos omitidos não mudam.
+//	@Tags			Clients
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header		string								true	"Token de autenticação (Bearer token)"
+//	@Param			request			body		service.ProfileUpdateRequest		true	"Campos a atualizar"
+//	@Success		200				{object}	domain.Client
+//	@Failure		400				{object}	map[string]string	"Dados inválidos"
+//	@Failure		401				{object}	map[string]string	"Não autorizado"
+//	@Router			/client/me [patch]
+func (h *ClientHandler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
+	clientID, ok := clientIDFromContext(r)
+	if !ok {
+		http.Error(w, "Usuário não autenticado", http.StatusUnauthorized)
+		return
+	}
+
+	var req service.ProfileUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Erro ao decodificar JSON", http.StatusBadRequest)
+		return
+	}
+
+	user, err := h.authService.UpdateProfile(clientID, &req)
+	if err != nil {
+		writeAccountError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(user)
+}
+
+// ChangePassword godoc
+//
+//	@Summary		Trocar senha do cliente
+//	@Description	Troca a senha do cliente autenticado, confirmando a senha atual
+//	@Tags			Auth
+//	@Accept			json
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header	string							true	"Token de autenticação (Bearer token)"
+//	@Param			request			body	service.ChangePasswordRequest	true	"Senha atual e nova"
+//	@Success		204
+//	@Failure		400	{object}	map[string]string	"Nova senha inválida"
+//	@Failure		403	{object}	map[string]string	"Senha atual incorreta"
+//	@Router			/client/me/password [put]
+func (h *ClientHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	clientID, ok := clientIDFromContext(r)
+	if !ok {
+		http.Error(w, "Usuário não autenticado", http.StatusUnauthorized)
+		return
+	}
+
+	var req service.ChangePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Erro ao decodificar JSON", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.authService.ChangePassword(clientID, &req); err != nil {
+		writeAccountError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RequestEmailChange godoc
+//
+//	@Summary		Trocar e-mail do cliente
+//	@Description	Envia um token de confirmação para o novo e-mail. O e-mail atual continua valendo até a confirmação.
+//	@Tags			Clients
+//	@Accept			json
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header	string						true	"Token de autenticação (Bearer token)"
+//	@Param			request			body	service.EmailChangeRequest	true	"Novo e-mail e senha atual"
+//	@Success		202
+//	@Failure		400	{object}	map[string]string	"E-mail inválido"
+//	@Failure		403	{object}	map[string]string	"Senha incorreta"
+//	@Failure		409	{object}	map[string]string	"E-mail já cadastrado"
+//	@Router			/client/me/email [post]
+func (h *ClientHandler) RequestEmailChange(w http.ResponseWriter, r *http.Request) {
+	clientID, ok := clientIDFromContext(r)
+	if !ok {
+		http.Error(w, "Usuário não autenticado", http.StatusUnauthorized)
+		return
+	}
+
+	var req service.EmailChangeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Erro ao decodificar JSON", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.authService.RequestEmailChange(clientID, &req); err != nil {
+		writeAccountError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// ConfirmEmailChange godoc
+//
+//	@Summary		Confirmar troca de e-mail
+//	@Description	Aplica a troca de e-mail com o token enviado para o novo endereço. O token vale uma vez.
+//	@Tags			Clients
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		ConfirmEmailRequest	true	"Token de confirmação"
+//	@Success		200		{object}	domain.Client
+//	@Failure		400		{object}	map[string]string	"Token inválido ou expirado"
+//	@Failure		409		{object}	map[string]string	"E-mail já cadastrado"
+//	@Router			/client/confirm-email [post]
+func (h *ClientHandler) ConfirmEmailChange(w http.ResponseWriter, r *http.Request) {
+	var req ConfirmEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Erro ao decodificar JSON", http.StatusBadRequest)
+		return
+	}
+
+	user, err := h.authService.ConfirmEmailChange(req.Token)
+	if err != nil {
+		writeAccountError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(user)
+}
+
+// DeleteAccount godoc
+//
+//	@Summary		Excluir conta do cliente
+//	@Description	Anonimiza os dados pessoais e remove a conta do cliente autenticado. Agendamentos e transações ficam no histórico, sem o endereço. Recusada enquanto houver agendamentos ativos por acontecer.
+//	@Tags			Clients
+//	@Accept			json
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header	string					true	"Token de autenticação (Bearer token)"
+//	@Param			request			body	DeleteAccountRequest	true	"Confirmação da senha"
+//	@Success		204
+//	@Failure		403	{object}	map[string]string	"Senha incorreta"
+//	@Failure		409	{object}	map[string]string	"Há agendamentos ativos"
+//	@Router			/client/me [delete]
+func (h *ClientHandler) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	clientID, ok := clientIDFromContext(r)
+	if !ok {
+		http.Error(w, "Usuário não autenticado", http.StatusUnauthorized)
+		return
+	}
+
+	var req DeleteAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Erro ao decodificar JSON", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.authService.DeleteAccount(clientID, req.Password); err != nil {
+		writeAccountError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ResendVerification godoc
+//
+//	@Summary		Reenviar verificação de e-mail
+//	@Description	Reenvia o link de verificação para o e-mail do cliente autenticado (no máximo uma vez por minuto)
+//	@Tags			Clients
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header	string	true	"Token de autenticação (Bearer token)"
+//	@Success		202
+//	@Failure		409	{object}	map[string]string	"E-mail já verificado"
+//	@Failure		429	{object}	map[string]string	"Reenvio recente"
+//	@Router			/client/me/resend-verification [post]
+func (h *ClientHandler) ResendVerification(w http.ResponseWriter, r *http.Request) {
+	clientID, ok := clientIDFromContext(r)
+	if !ok {
+		http.Error(w, "Usuário não autenticado", http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.authService.ResendVerification(clientID); err != nil {
+		writeAccountError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// ListAddresses godoc
+//
+//	@Summary		Listar endereços salvos
+//	@Description	Lista os endereços do cliente autenticado, o padrão primeiro
+//	@Tags			Clients
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header	string	true	"Token de autenticação (Bearer token)"
+//	@Success		200				{array}	domain.SavedAddress
+//	@Failure		401				{object}	map[string]string	"Não autorizado"
+//	@Router			/client/me/addresses [get]
+func (h *ClientHandler) ListAddresses(w http.ResponseWriter, r *http.Request) {
+	clientID, ok := clientIDFromContext(r)
+	if !ok {
+		http.Error(w, "Usuário não autenticado", http.StatusUnauthorized)
+		return
+	}
+
+	addresses, err := h.authService.ListAddresses(clientID)
+	if err != nil {
+		writeAccountError(w, err)
+		return
+	}
+	if addresses == nil {
+		addresses = []domain.SavedAddress{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(addresses)
+}
+
+// CreateAddress godoc
+//
+//	@Summary		Salvar endereço
+//	@Description	Salva um endereço do cliente autenticado (até 10). O primeiro vira o padrão.
+//	@Tags			Clients
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header		string				true	"Token de autenticação (Bearer token)"
+//	@Param			address			body		domain.SavedAddress	true	"Apelido, endereço e padrão"
+//	@Success		201				{object}	domain.SavedAddress
+//	@Failure		400				{object}	map[string]string	"Endereço inválido"
+//	@Failure		409				{object}	map[string]string	"Limite de endereços atingido"
+//	@Router			/client/me/addresses [post]
+func (h *ClientHandler) CreateAddress(w http.ResponseWriter, r *http.Request) {
+	clientID, ok := clientIDFromContext(r)
+	if !ok {
+		http.Error(w, "Usuário não autenticado", http.StatusUnauthorized)
+		return
+	}
+
+	var address domain.SavedAddress
+	if err := json.NewDecoder(r.Body).Decode(&address); err != nil {
+		http.Error(w, "Erro ao decodificar JSON", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.authService.CreateAddress(clientID, &address); err != nil {
+		writeAccountError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(address)
+}
+
+// UpdateAddress godoc
+//
+//	@Summary		Atualizar endereço salvo
+//	@Description	Substitui apelido e endereço; is_default=true torna este o endereço padrão
+//	@Tags			Clients
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header		string				true	"Token de autenticação (Bearer token)"
+//	@Param			address_id		path		int					true	"ID do endereço"
+//	@Param			address			body		domain.SavedAddress	true	"Apelido, endereço e padrão"
+//	@Success		200				{object}	domain.SavedAddress
+//	@Failure		400				{object}	map[string]string	"Endereço inválido"
+//	@Failure		404				{object}	map[string]string	"Endereço não encontrado"
+//	@Router			/client/me/addresses/{address_id} [put]
+func (h *ClientHandler) UpdateAddress(w http.ResponseWriter, r *http.Request) {
+	clientID, ok := clientIDFromContext(r)
+	if !ok {
+		http.Error(w, "Usuário não autenticado", http.StatusUnauthorized)
+		return
+	}
+	id, err := strconv.ParseUint(mux.Vars(r)["address_id"], 10, 32)
+	if err != nil {
+		http.Error(w, "ID inválido", http.StatusBadRequest)
+		return
+	}
+
+	var update domain.SavedAddress
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		http.Error(w, "Erro ao decodificar JSON", http.StatusBadRequest)
+		return
+	}
+
+	address, err := h.authService.UpdateAddress(clientID, uint(id), &update)
+	if err != nil {
+		writeAccountError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(address)
+}
+
+// DeleteAddress godoc
+//
+//	@Summary		Remover endereço salvo
+//	@Description	Remove o endereço; se era o padrão, o mais antigo restante assume
+//	@Tags			Clients
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header	string	true	"Token de autenticação (Bearer token)"
+//	@Param			address_id		path	int		true	"ID do endereço"
+//	@Success		204
+//	@Failure		404	{object}	map[string]string	"Endereço não encontrado"
+//	@Router			/client/me/addresses/{address_id} [delete]
+func (h *ClientHandler) DeleteAddress(w http.ResponseWriter, r *http.Request) {
+	clientID, ok := clientIDFromContext(r)
+	if !ok {
+		http.Error(w, "Usuário não autenticado", http.StatusUnauthorized)
+		return
+	}
+	id, err := strconv.ParseUint(mux.Vars(r)["address_id"], 10, 32)
+	if err != nil {
+		http.Error(w, "ID inválido", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.authService.DeleteAddress(clientID, uint(id)); err != nil {
+		writeAccountError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// clientIDFromContext lê o ID do cliente das claims do AuthMiddleware
+func clientIDFromContext(r *http.Request) (uint, bool) {
+	claims, ok := r.Context().Value(middleware.UserContextKey).(jwt.MapClaims)
+	if !ok {
+		return 0, false
+	}
+	id, ok := claims["user_id"].(float64)
+	if !ok {
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// writeAccountError traduz os erros da conta do cliente em status HTTP
+func writeAccountError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrInvalidProfile), errors.Is(err, auth.ErrInvalidPassword),
+		errors.Is(err, domain.ErrInvalidEmail), errors.Is(err, auth.ErrInvalidToken),
+		errors.Is(err, domain.ErrInvalidLabel), errors.Is(err, geo.ErrInvalidAddress),
+		errors.Is(err, timezone.ErrInvalid):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, auth.ErrWrongPassword):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, domain.ErrClientNotFound):
+		http.Error(w, "Usuário não encontrado", http.StatusNotFound)
+	case errors.Is(err, domain.ErrAddressNotFound):
+		http.Error(w, "Endereço não encontrado", http.StatusNotFound)
+	case errors.Is(err, domain.ErrEmailInUse), errors.Is(err, domain.ErrActiveBookings),
+		errors.Is(err, domain.ErrTooManyAddresses), errors.Is(err, domain.ErrAlreadyVerified):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, auth.ErrResendTooSoon):
+		w.Header().Set("Retry-After", strconv.Itoa(int(auth.VerificationResendInterval.Seconds())))
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+	default:
+		log.Println("❌ Erro na conta do cliente:", err)
+		http.Error(w, "Erro ao processar a requisição", http.StatusInternalServerError)
+	}
+}
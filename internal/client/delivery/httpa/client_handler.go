@@ -3,7 +3,9 @@ package httpa
 import (
 	"1mao/internal/client/domain"
 	"1mao/internal/client/service"
+	"1mao/pkg/timezone"
 	"encoding/json"
+	"errors"
 	"net/http"
 )
 
@@ -14,6 +16,7 @@ type RegisterRequest struct {
 	Email    string `json:"email" example:"cliente@example.com"`
 	Password string `json:"password" example:"senhaSegura123"`
 	Phone    string `json:"phone" example:"+5511999999999"`
+	TimeZone string `json:"time_zone" example:"America/Sao_Paulo"`
 }
 
 // LoginRequest define a estrutura para login de clientes
@@ -61,6 +64,10 @@ func (h *ClientHandler) Register(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.authService.Register(&user); err != nil {
+		if errors.Is(err, timezone.ErrInvalid) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -112,7 +119,7 @@ func (h *ClientHandler) Login(w http.ResponseWriter, r *http.Request) {
 //	@Failure		401	{object}	map[string]string	"Não autorizado"
 //	@Router			/client/me [get]
 func (h *ClientHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
-	userID, ok := r.Context().Value("userID").(uint)
+	userID, ok := clientIDFromContext(r)
 	if !ok {
 		http.Error(w, "Usuário não autenticado", http.StatusUnauthorized)
 		return
@@ -149,17 +156,68 @@ func (h *ClientHandler) GetAllUsers(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(users)
 }
 
+// ForgotPasswordRequest pede o token de redefinição de senha
+//
+//	@Description	E-mail da conta
+type ForgotPasswordRequest struct {
+	Email string `json:"email" example:"cliente@example.com"`
+}
+
+// ResetPasswordRequest troca a senha usando o token recebido por e-mail
+//
+//	@Description	Token de redefinição e nova senha (mínimo de 8 caracteres)
+type ResetPasswordRequest struct {
+	Token       string `json:"token" example:"q3J6c2Vi..."`
+	NewPassword string `json:"new_password" example:"senhaNova456"`
+}
+
+// ForgotPassword godoc
+//
+//	@Summary		Esqueci minha senha
+//	@Description	Envia um token de redefinição (válido por 1 hora) para o e-mail, se ele estiver cadastrado. A resposta é a mesma em ambos os casos.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		ForgotPasswordRequest	true	"E-mail da conta"
+//	@Success		200		{object}	map[string]string
+//	@Failure		400		{object}	map[string]string	"Requisição inválida"
+//	@Router			/client/forgot-password [post]
 func (h *ClientHandler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
-	var req struct {
-		Email string `json:"email"`
+	var req ForgotPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Email == "" {
+		http.Error(w, "Erro ao decodificar JSON", http.StatusBadRequest)
+		return
 	}
-	json.NewDecoder(r.Body).Decode(&req)
 	message, err := h.authService.ForgotPassword(req.Email)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeAccountError(w, err)
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]string{"message": message})
 }
+
+// ResetPassword godoc
+//
+//	@Summary		Redefinir senha
+//	@Description	Troca a senha com o token recebido por e-mail. O token vale uma única vez.
+//	@Tags			Auth
+//	@Accept			json
+//	@Param			request	body	ResetPasswordRequest	true	"Token e nova senha"
+//	@Success		204
+//	@Failure		400	{object}	map[string]string	"Token inválido ou expirado, ou senha inválida"
+//	@Router			/client/reset-password [post]
+func (h *ClientHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var req ResetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Erro ao decodificar JSON", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.authService.ResetPassword(req.Token, req.NewPassword); err != nil {
+		writeAccountError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
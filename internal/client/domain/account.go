@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"1mao/pkg/auth"
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// MaxNameLength limita o nome exibido do cliente
+	MaxNameLength = 120
+	// EmailChangeTTL é a validade do token enviado para o novo e-mail
+	EmailChangeTTL = 24 * time.Hour
+)
+
+var (
+	ErrClientNotFound   = errors.New("client not found")
+	ErrInvalidProfile   = errors.New("invalid profile")
+	ErrInvalidEmail     = errors.New("invalid email")
+	ErrEmailInUse       = errors.New("email already in use")
+	ErrActiveBookings   = errors.New("client has active bookings")
+	ErrAddressNotFound  = errors.New("address not found")
+	ErrTooManyAddresses = errors.New("too many saved addresses")
+	ErrInvalidLabel     = errors.New("invalid address label")
+	ErrAlreadyVerified  = errors.New("email already verified")
+)
+
+// ValidateProfile normaliza e valida os campos editáveis do perfil
+func (c *Client) ValidateProfile() error {
+	c.Name = strings.TrimSpace(c.Name)
+	c.Phone = strings.TrimSpace(c.Phone)
+
+	if c.Name == "" || utf8.RuneCountInString(c.Name) > MaxNameLength {
+		return ErrInvalidProfile
+	}
+	if c.Phone != "" && !auth.ValidPhone(c.Phone) {
+		return ErrInvalidProfile
+	}
+	return nil
+}
+
+// NormalizeEmail apara o endereço e rejeita o que não for um e-mail simples
+func NormalizeEmail(email string) (string, error) {
+	email = strings.TrimSpace(email)
+	parsed, err := mail.ParseAddress(email)
+	if err != nil || parsed.Address != email {
+		return "", ErrInvalidEmail
+	}
+	return email, nil
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"1mao/pkg/geo"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// MaxSavedAddresses limita o catálogo de endereços de cada cliente
+	MaxSavedAddresses = 10
+	// MaxAddressLabelLength limita o apelido do endereço ("Casa", "Trabalho")
+	MaxAddressLabelLength = 50
+)
+
+// SavedAddress é um endereço guardado pelo cliente para reaproveitar nos
+// agendamentos. No máximo um fica marcado como padrão.
+//
+//	@Description	Endereço salvo do cliente
+//	@name			SavedAddress
+//	@model			SavedAddress
+type SavedAddress struct {
+	ID        uint        `json:"id" gorm:"primaryKey"`
+	ClientID  uint        `json:"client_id" gorm:"index;not null"`
+	Label     string      `json:"label" gorm:"size:50;not null" example:"Casa"`
+	Address   geo.Address `json:"address" gorm:"embedded;embeddedPrefix:address_"`
+	IsDefault bool        `json:"is_default" gorm:"not null;default:false"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
+// Validate normaliza o apelido e o endereço
+func (a *SavedAddress) Validate() error {
+	a.Label = strings.TrimSpace(a.Label)
+	if a.Label == "" || utf8.RuneCountInString(a.Label) > MaxAddressLabelLength {
+		return ErrInvalidLabel
+	}
+	return a.Address.Normalize()
+}
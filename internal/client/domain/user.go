@@ -2,8 +2,9 @@
 package domain
 
 import (
-	_ "gorm.io/gorm"
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type Role string
@@ -26,6 +27,22 @@ type Client struct {
 	Role             Role      `json:"role" gorm:"type:varchar(20);not null;default:client"`
 	LastLogin        time.Time `json:"last_login"`
 	Phone            string    `json:"phone"`
-	ResetToken       string    `json:"-" swaggerignore:"true"`
+	ResetToken       string    `json:"-" gorm:"index" swaggerignore:"true"`
 	ResetTokenExpiry time.Time `json:"-" swaggerignore:"true"`
+	// Fuso IANA em que os horários são exibidos para o cliente
+	TimeZone string `json:"time_zone" gorm:"type:varchar(64);not null;default:America/Sao_Paulo" example:"America/Sao_Paulo"`
+	// Idioma dos e-mails enviados ao cliente (pt-BR ou en)
+	Locale string `json:"locale" gorm:"type:varchar(10);not null;default:pt-BR" example:"pt-BR"`
+	// Novo e-mail aguardando confirmação; só substitui Email depois que o
+	// cliente usa o token enviado para ele
+	PendingEmail string `json:"pending_email,omitempty" example:"novo@example.com"`
+	// Hash SHA-256 do token de troca de e-mail
+	EmailChangeToken  string         `json:"-" gorm:"index" swaggerignore:"true"`
+	EmailChangeExpiry time.Time      `json:"-" swaggerignore:"true"`
+	DeletedAt         gorm.DeletedAt `json:"-" gorm:"index" swaggerignore:"true"`
+	// Momento em que o cliente confirmou o e-mail; sem ele não há agendamento
+	// nem pagamento
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	// Último envio do link de verificação, para limitar os reenvios
+	VerificationSentAt time.Time `json:"-" swaggerignore:"true"`
 }
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	booking "1mao/internal/booking/domain"
+	"1mao/internal/client/domain"
+	"1mao/pkg/auth"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FindByEmailChangeToken busca o cliente pelo hash do token de troca de e-mail
+func (r *userRepository) FindByEmailChangeToken(hash string) (*domain.Client, error) {
+	var user domain.Client
+	if err := r.db.Where("email_change_token = ?", hash).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, auth.ErrInvalidToken
+		}
+		return nil, err
+	}
+	return &user, nil
+}
+
+// ResetPassword grava a nova senha se o token ainda valer, consumindo-o na
+// mesma atualização para que dois pedidos com o mesmo token não passem
+func (r *userRepository) ResetPassword(tokenHash, password string, now time.Time) error {
+	result := r.db.Model(&domain.Client{}).
+		Where("reset_token = ? AND reset_token_expiry > ?", tokenHash, now).
+		Updates(map[string]interface{}{
+			"password":           password,
+			"reset_token":        "",
+			"reset_token_expiry": now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return auth.ErrInvalidToken
+	}
+	return nil
+}
+
+// DeleteAccount anonimiza o cliente e o remove (soft delete). Os agendamentos
+// e as transações continuam existindo com o mesmo client_id, mas perdem o
+// endereço de atendimento; endereços salvos e entradas na lista de espera são
+// apagados. Recusa se houver agendamentos ativos ainda por acontecer.
+func (r *userRepository) DeleteAccount(id uint, now time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var user domain.Client
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&user, id).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrClientNotFound
+			}
+			return err
+		}
+
+		var active int64
+		if err := tx.Model(&booking.Booking{}).
+			Where("client_id = ? AND status IN ? AND end_time > ?", id, booking.ActiveStatuses, now).
+			Count(&active).Error; err != nil {
+			return err
+		}
+		if active > 0 {
+			return domain.ErrActiveBookings
+		}
+
+		if err := tx.Where("client_id = ?", id).Delete(&domain.SavedAddress{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("client_id = ?", id).Delete(&booking.WaitlistEntry{}).Error; err != nil {
+			return err
+		}
+
+		serviceAddress := make(map[string]interface{}, len(addressFields))
+		for _, field := range addressFields {
+			serviceAddress["service_address_"+field] = nil
+		}
+		if err := tx.Model(&booking.Booking{}).Where("client_id = ?", id).Updates(serviceAddress).Error; err != nil {
+			return err
+		}
+
+		// O e-mail anonimizado libera o endereço original para um novo cadastro;
+		// a senha vazia nunca confere no login
+		anonymized := map[string]interface{}{
+			"name":               "Cliente removido",
+			"email":              fmt.Sprintf("deleted-%d@removed.invalid", id),
+			"password":           "",
+			"phone":              "",
+			"reset_token":        "",
+			"pending_email":      "",
+			"email_change_token": "",
+		}
+		if err := tx.Model(&user).Updates(anonymized).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&user).Error
+	})
+}
+
+// addressFields são as colunas de geo.Address, sem o prefixo do embed
+var addressFields = []string{
+	"street", "number", "complement", "district", "city", "state", "postal_code", "latitude", "longitude",
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"1mao/internal/client/domain"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ListAddresses devolve os endereços do cliente, o padrão primeiro
+func (r *userRepository) ListAddresses(clientID uint) ([]domain.SavedAddress, error) {
+	var addresses []domain.SavedAddress
+	err := r.db.Where("client_id = ?", clientID).
+		Order("is_default DESC, created_at ASC, id ASC").
+		Find(&addresses).Error
+	return addresses, err
+}
+
+func (r *userRepository) FindAddress(clientID, id uint) (*domain.SavedAddress, error) {
+	var address domain.SavedAddress
+	if err := r.db.Where("client_id = ?", clientID).First(&address, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrAddressNotFound
+		}
+		return nil, err
+	}
+	return &address, nil
+}
+
+// CreateAddress grava um novo endereço respeitando o limite por cliente. O
+// primeiro endereço vira o padrão; um novo padrão desmarca o anterior.
+func (r *userRepository) CreateAddress(address *domain.SavedAddress) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockClient(tx, address.ClientID); err != nil {
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&domain.SavedAddress{}).Where("client_id = ?", address.ClientID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count >= domain.MaxSavedAddresses {
+			return domain.ErrTooManyAddresses
+		}
+		if count == 0 {
+			address.IsDefault = true
+		}
+
+		if address.IsDefault {
+			if err := clearDefaultAddress(tx, address.ClientID); err != nil {
+				return err
+			}
+		}
+		return tx.Create(address).Error
+	})
+}
+
+// UpdateAddress salva o endereço; marcá-lo como padrão desmarca o anterior
+func (r *userRepository) UpdateAddress(address *domain.SavedAddress) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockClient(tx, address.ClientID); err != nil {
+			return err
+		}
+		if address.IsDefault {
+			if err := clearDefaultAddress(tx, address.ClientID); err != nil {
+				return err
+			}
+		}
+		return tx.Save(address).Error
+	})
+}
+
+// DeleteAddress remove o endereço; se era o padrão, o mais antigo restante
+// assume
+func (r *userRepository) DeleteAddress(clientID, id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockClient(tx, clientID); err != nil {
+			return err
+		}
+
+		var address domain.SavedAddress
+		if err := tx.Where("client_id = ?", clientID).First(&address, id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrAddressNotFound
+			}
+			return err
+		}
+		if err := tx.Delete(&address).Error; err != nil {
+			return err
+		}
+		if !address.IsDefault {
+			return nil
+		}
+
+		var next domain.SavedAddress
+		err := tx.Where("client_id = ?", clientID).Order("created_at ASC, id ASC").First(&next).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return tx.Model(&next).Update("is_default", true).Error
+	})
+}
+
+// lockClient trava a linha do cliente para serializar as mudanças no catálogo
+// de endereços
+func lockClient(tx *gorm.DB, clientID uint) error {
+	var user domain.Client
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&user, clientID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.ErrClientNotFound
+	}
+	return err
+}
+
+func clearDefaultAddress(tx *gorm.DB, clientID uint) error {
+	return tx.Model(&domain.SavedAddress{}).
+		Where("client_id = ? AND is_default", clientID).
+		Update("is_default", false).Error
+}
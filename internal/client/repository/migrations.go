@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// Migrate ajusta os dados que o AutoMigrate não cobre. Deve ser chamado
+// depois do AutoMigrate de domain.Client.
+//
+// Contas anteriores à verificação de e-mail ganharam email_verified_at nulo
+// e ficariam bloqueadas para agendar e pagar; elas contam como verificadas.
+// Só essas contas têm verification_sent_at nulo (o cadastro e o reenvio
+// sempre o preenchem), o que torna o ajuste seguro de repetir a cada subida.
+// A tabela não tem created_at, então a data usada é a do ajuste.
+func Migrate(db *gorm.DB) error {
+	err := db.Exec(`UPDATE clients SET email_verified_at = NOW()
+		WHERE email_verified_at IS NULL AND verification_sent_at IS NULL`).Error
+	if err != nil {
+		return fmt.Errorf("erro ao marcar e-mails de clientes antigos como verificados: %w", err)
+	}
+	return nil
+}
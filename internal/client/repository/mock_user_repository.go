@@ -2,6 +2,7 @@ package repository
 
 import (
 	"1mao/internal/client/domain"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 )
@@ -40,3 +41,49 @@ func (m *MockClientRepository) UpdateUser(user *domain.Client) error{
 	args := m.Called(user)
 	return args.Error(0)
 }
+
+func (m *MockClientRepository) FindByEmailChangeToken(hash string) (*domain.Client, error) {
+	args := m.Called(hash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Client), args.Error(1)
+}
+
+func (m *MockClientRepository) ResetPassword(tokenHash, password string, now time.Time) error {
+	args := m.Called(tokenHash, password, now)
+	return args.Error(0)
+}
+
+func (m *MockClientRepository) DeleteAccount(id uint, now time.Time) error {
+	args := m.Called(id, now)
+	return args.Error(0)
+}
+
+func (m *MockClientRepository) ListAddresses(clientID uint) ([]domain.SavedAddress, error) {
+	args := m.Called(clientID)
+	return args.Get(0).([]domain.SavedAddress), args.Error(1)
+}
+
+func (m *MockClientRepository) FindAddress(clientID, id uint) (*domain.SavedAddress, error) {
+	args := m.Called(clientID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SavedAddress), args.Error(1)
+}
+
+func (m *MockClientRepository) CreateAddress(address *domain.SavedAddress) error {
+	args := m.Called(address)
+	return args.Error(0)
+}
+
+func (m *MockClientRepository) UpdateAddress(address *domain.SavedAddress) error {
+	args := m.Called(address)
+	return args.Error(0)
+}
+
+func (m *MockClientRepository) DeleteAddress(clientID, id uint) error {
+	args := m.Called(clientID, id)
+	return args.Error(0)
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"1mao/internal/client/domain"
 	"log"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -13,6 +14,15 @@ type UserRepository interface {
 	FindByID(userID uint) (*domain.Client, error)
 	GetAllUsers() ([]domain.Client, error)
 	UpdateUser(user *domain.Client) error 
+	FindByEmailChangeToken(hash string) (*domain.Client, error)
+	ResetPassword(tokenHash, password string, now time.Time) error
+	DeleteAccount(id uint, now time.Time) error
+
+	ListAddresses(clientID uint) ([]domain.SavedAddress, error)
+	FindAddress(clientID, id uint) (*domain.SavedAddress, error)
+	CreateAddress(address *domain.SavedAddress) error
+	UpdateAddress(address *domain.SavedAddress) error
+	DeleteAddress(clientID, id uint) error
 }
 
 type userRepository struct {
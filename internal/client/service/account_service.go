@@ -0,0 +1,212 @@
+package service
+
+import (
+	"1mao/internal/client/domain"
+	"1mao/pkg/auth"
+	"1mao/pkg/email"
+	"1mao/pkg/timezone"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// ProfileUpdateRequest define os campos editáveis do perfil; campos ausentes
+// ficam como estão
+//
+//	@Description	Atualização parcial do perfil do cliente
+//	@name			ClientProfileUpdateRequest
+//	@model			ClientProfileUpdateRequest
+type ProfileUpdateRequest struct {
+	Name     *string `json:"name,omitempty" example:"João Silva"`
+	Phone    *string `json:"phone,omitempty" example:"+5511999999999"`
+	TimeZone *string `json:"time_zone,omitempty" example:"America/Sao_Paulo"`
+	Locale   *string `json:"locale,omitempty" example:"pt-BR"`
+}
+
+// ChangePasswordRequest define a troca de senha
+//
+//	@Description	Senha atual e nova senha (mínimo de 8 caracteres)
+//	@name			ClientChangePasswordRequest
+//	@model			ClientChangePasswordRequest
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" example:"senhaAntiga123"`
+	NewPassword     string `json:"new_password" example:"senhaNova456"`
+}
+
+// EmailChangeRequest pede a troca de e-mail, confirmada pela senha atual
+//
+//	@Description	Novo e-mail e senha atual
+//	@name			EmailChangeRequest
+//	@model			EmailChangeRequest
+type EmailChangeRequest struct {
+	NewEmail string `json:"new_email" example:"novo@example.com"`
+	Password string `json:"password" example:"senhaSegura123"`
+}
+
+// findClient mapeia o registro ausente para ErrClientNotFound
+func (s *clientService) findClient(id uint) (*domain.Client, error) {
+	user, err := s.userRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrClientNotFound
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// 🔹 Atualização parcial do perfil
+func (s *clientService) UpdateProfile(clientID uint, req *ProfileUpdateRequest) (*domain.Client, error) {
+	user, err := s.findClient(clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		user.Name = *req.Name
+	}
+	if req.Phone != nil {
+		user.Phone = *req.Phone
+	}
+	if req.TimeZone != nil {
+		zone, err := timezone.Normalize(*req.TimeZone)
+		if err != nil {
+			return nil, err
+		}
+		user.TimeZone = zone
+	}
+	if req.Locale != nil {
+		locale, ok := email.MatchLocale(*req.Locale)
+		if !ok {
+			return nil, domain.ErrInvalidProfile
+		}
+		user.Locale = locale
+	}
+	if err := user.ValidateProfile(); err != nil {
+		return nil, err
+	}
+
+	if err := s.userRepo.UpdateUser(user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// 🔹 Troca de senha, confirmando a senha atual
+func (s *clientService) ChangePassword(clientID uint, req *ChangePasswordRequest) error {
+	user, err := s.findClient(clientID)
+	if err != nil {
+		return err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.CurrentPassword)); err != nil {
+		return auth.ErrWrongPassword
+	}
+	if err := auth.ValidatePassword(req.NewPassword); err != nil {
+		return err
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	user.Password = string(hashedPassword)
+	return s.userRepo.UpdateUser(user)
+}
+
+// 🔹 Pede a troca de e-mail. O endereço atual continua valendo até o cliente
+// confirmar o token enviado para o novo endereço.
+func (s *clientService) RequestEmailChange(clientID uint, req *EmailChangeRequest) error {
+	user, err := s.findClient(clientID)
+	if err != nil {
+		return err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+		return auth.ErrWrongPassword
+	}
+
+	email, err := domain.NormalizeEmail(req.NewEmail)
+	if err != nil {
+		return err
+	}
+	if email == user.Email {
+		return domain.ErrInvalidEmail
+	}
+	if err := s.ensureEmailAvailable(email); err != nil {
+		return err
+	}
+
+	token, err := auth.NewOneTimeToken()
+	if err != nil {
+		return err
+	}
+	user.PendingEmail = email
+	user.EmailChangeToken = auth.HashToken(token)
+	user.EmailChangeExpiry = time.Now().Add(domain.EmailChangeTTL)
+	if err := s.userRepo.UpdateUser(user); err != nil {
+		return err
+	}
+
+	if err := s.sendEmailChangeEmail(user, email, token); err != nil {
+		return fmt.Errorf("erro ao enviar email: %w", err)
+	}
+	return nil
+}
+
+// 🔹 Confirma a troca de e-mail com o token recebido no novo endereço. O
+// token vale uma única vez.
+func (s *clientService) ConfirmEmailChange(token string) (*domain.Client, error) {
+	if token == "" {
+		return nil, auth.ErrInvalidToken
+	}
+	user, err := s.userRepo.FindByEmailChangeToken(auth.HashToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if user.PendingEmail == "" || time.Now().After(user.EmailChangeExpiry) {
+		return nil, auth.ErrInvalidToken
+	}
+	// Outro cadastro pode ter usado o endereço depois do pedido
+	if err := s.ensureEmailAvailable(user.PendingEmail); err != nil {
+		return nil, err
+	}
+
+	// O token chegou no novo endereço, então ele já está verificado
+	now := time.Now()
+	user.Email = user.PendingEmail
+	user.EmailVerifiedAt = &now
+	user.PendingEmail = ""
+	user.EmailChangeToken = ""
+	user.EmailChangeExpiry = time.Time{}
+	if err := s.userRepo.UpdateUser(user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *clientService) ensureEmailAvailable(email string) error {
+	_, err := s.userRepo.FindByEmail(email)
+	if err == nil {
+		return domain.ErrEmailInUse
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return nil
+}
+
+// 🔹 Exclui a conta (LGPD), confirmando a senha. Os dados pessoais são
+// anonimizados; agendamentos e transações ficam para o histórico dos
+// profissionais e a conciliação financeira.
+func (s *clientService) DeleteAccount(clientID uint, password string) error {
+	user, err := s.findClient(clientID)
+	if err != nil {
+		return err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
+		return auth.ErrWrongPassword
+	}
+	return s.userRepo.DeleteAccount(clientID, time.Now())
+}
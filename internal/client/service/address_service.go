@@ -0,0 +1,41 @@
+package service
+
+import "1mao/internal/client/domain"
+
+// 🔹 Endereços salvos do cliente
+func (s *clientService) ListAddresses(clientID uint) ([]domain.SavedAddress, error) {
+	return s.userRepo.ListAddresses(clientID)
+}
+
+func (s *clientService) CreateAddress(clientID uint, address *domain.SavedAddress) error {
+	address.ID = 0
+	address.ClientID = clientID
+	if err := address.Validate(); err != nil {
+		return err
+	}
+	return s.userRepo.CreateAddress(address)
+}
+
+// UpdateAddress substitui apelido e endereço. O padrão só muda marcando outro
+// endereço; desmarcar o atual deixaria o cliente sem padrão.
+func (s *clientService) UpdateAddress(clientID, id uint, update *domain.SavedAddress) (*domain.SavedAddress, error) {
+	address, err := s.userRepo.FindAddress(clientID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	address.Label = update.Label
+	address.Address = update.Address
+	address.IsDefault = address.IsDefault || update.IsDefault
+	if err := address.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.userRepo.UpdateAddress(address); err != nil {
+		return nil, err
+	}
+	return address, nil
+}
+
+func (s *clientService) DeleteAddress(clientID, id uint) error {
+	return s.userRepo.DeleteAddress(clientID, id)
+}
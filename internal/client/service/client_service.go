@@ -4,12 +4,17 @@ import (
 	"1mao/internal/client/domain"
 	"1mao/internal/client/repository"
 	"1mao/pkg/auth"
+	"1mao/pkg/email"
+	"1mao/pkg/timezone"
+	"context"
 	"errors"
 	"fmt"
+	"log"
+	"strings"
 	"time"
 
-	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
 )
 
 type ClientService interface {
@@ -19,11 +24,25 @@ type ClientService interface {
 	Login(email, password string) (string, error)
 	GetAllUsers() ([]domain.Client, error)
 	ForgotPassword(email string) (string, error)
+	ResetPassword(token, newPassword string) error
+	VerifyEmail(clientID uint, email string) error
+	ResendVerification(clientID uint) error
+	UpdateProfile(clientID uint, req *ProfileUpdateRequest) (*domain.Client, error)
+	ChangePassword(clientID uint, req *ChangePasswordRequest) error
+	RequestEmailChange(clientID uint, req *EmailChangeRequest) error
+	ConfirmEmailChange(token string) (*domain.Client, error)
+	DeleteAccount(clientID uint, password string) error
+
+	ListAddresses(clientID uint) ([]domain.SavedAddress, error)
+	CreateAddress(clientID uint, address *domain.SavedAddress) error
+	UpdateAddress(clientID, id uint, update *domain.SavedAddress) (*domain.SavedAddress, error)
+	DeleteAddress(clientID, id uint) error
 }
 
 type clientService struct {
 	userRepo repository.UserRepository
-	authSvc auth.AuthService
+	authSvc  auth.AuthService
+	mailer   email.Mailer
 }
 
 type clientAuthAdapter struct {
@@ -49,13 +68,14 @@ func (s *clientService) FindByEmail(email string) (*auth.User, error) {
 
 
 
-func NewClientService(userRepo repository.UserRepository) ClientService {
+func NewClientService(userRepo repository.UserRepository, mailer email.Mailer) ClientService {
 	authRepo := &clientAuthAdapter{repo: userRepo} // 🔹 Criamos o adapter
 	authSvc := auth.NewAuthService(authRepo, nil) // 🔹 Agora passamos o adapter para AuthService
 
 	return &clientService{
 		userRepo: userRepo,
 		authSvc:  authSvc,
+		mailer:   mailer,
 	}
 }
 
@@ -63,6 +83,13 @@ func NewClientService(userRepo repository.UserRepository) ClientService {
 
 
 func (s *clientService) Register(user *domain.Client) error {
+	zone, err := timezone.Normalize(user.TimeZone)
+	if err != nil {
+		return err
+	}
+	user.TimeZone = zone
+	user.Locale = email.NormalizeLocale(user.Locale)
+
 	// Hash da senha do usuario
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
 	if err != nil {
@@ -70,9 +97,19 @@ func (s *clientService) Register(user *domain.Client) error {
 	}
 
 	user.Password = string(hashedPassword)
+	user.EmailVerifiedAt = nil
+	user.VerificationSentAt = time.Now()
 
 	// Salvar no banco
-	return s.userRepo.Create(user)
+	if err := s.userRepo.Create(user); err != nil {
+		return err
+	}
+
+	// A conta já existe; sem o e-mail o cliente pede o reenvio
+	if err := s.sendVerification(user); err != nil {
+		log.Printf("⚠️ Erro ao enviar verificação de e-mail para o cliente %d: %v", user.ID, err)
+	}
+	return nil
 }
 
 func (s *clientService) GetUserByID(userID uint) (*domain.Client, error) {
@@ -86,24 +123,56 @@ func (s *clientService) GetAllUsers() ([]domain.Client, error) {
 func (s *clientService) Login(email, password string)(string, error){
 	return s.authSvc.Login(email, password)
 }
-func (s *clientService) ForgotPassword(email string) (string, error){
-	
-	user, err := s.userRepo.FindByEmail(email)
-	if err != nil{
-		return "", errors.New("usuario nao encontrado")
+// ForgotPassword envia o token de redefinição de senha. A resposta é a mesma
+// para e-mails cadastrados ou não, para não revelar quem tem conta: o token e
+// o e-mail saem em segundo plano, sem atrasar a resposta, e falhas só são
+// registradas.
+func (s *clientService) ForgotPassword(address string) (string, error) {
+	const message = "Se o e-mail estiver cadastrado, enviaremos as instruções de recuperação"
+
+	user, err := s.userRepo.FindByEmail(strings.TrimSpace(address))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return message, nil
+		}
+		return "", err
+	}
+
+	email.SendAsync(context.Background(), fmt.Sprintf("redefinição de senha do cliente %d", user.ID), func(ctx context.Context) error {
+		return s.sendPasswordReset(ctx, user)
+	})
+	return message, nil
+}
+
+// sendPasswordReset gera um token único para redefinir a senha, salva só o
+// hash e envia o token ao cliente
+func (s *clientService) sendPasswordReset(ctx context.Context, user *domain.Client) error {
+	token, err := auth.NewOneTimeToken()
+	if err != nil {
+		return err
 	}
+	user.ResetToken = auth.HashToken(token)
+	user.ResetTokenExpiry = time.Now().Add(auth.ResetTokenTTL)
 
-	// Gerar um token unico para redefinir a senha
-	token := uuid.New().String()
-	user.ResetToken = token
-	user.ResetTokenExpiry = time.Now().Add(1 * time.Hour) // token expira em 1 hora
+	if err := s.userRepo.UpdateUser(user); err != nil {
+		return fmt.Errorf("erro ao salvar token de redefinição: %w", err)
+	}
+	return email.SendTemplate(ctx, s.mailer, user.Email, user.Locale, email.TemplatePasswordReset, email.PasswordResetData{Token: token})
+}
 
-	if err := s.userRepo.UpdateUser(user); err != nil{
-		return "", errors.New("erro ao salvar token de redefinição")
+// ResetPassword troca a senha usando o token recebido por e-mail. O token é
+// consumido na mesma atualização, então vale uma única vez.
+func (s *clientService) ResetPassword(token, newPassword string) error {
+	if token == "" {
+		return auth.ErrInvalidToken
+	}
+	if err := auth.ValidatePassword(newPassword); err != nil {
+		return err
 	}
 
-	if err := sendResetPasswordEmail(user.Email, token); err != nil{
-		return "", fmt.Errorf("erro ao enviar email: %w ", err)
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
 	}
-	return "Email de recuperação enviado", nil
-}
\ No newline at end of file
+	return s.userRepo.ResetPassword(auth.HashToken(token), string(hashedPassword), time.Now())
+}
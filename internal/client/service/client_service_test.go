@@ -3,12 +3,19 @@ package service
 import (
 	"1mao/internal/client/domain"
 	"1mao/internal/client/repository"
+	"1mao/pkg/auth"
+	"1mao/pkg/email"
+	"1mao/pkg/geo"
+	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
 )
 
 
@@ -19,7 +26,7 @@ func hashPassword(password string) string{
 
 func TestRegister_Sucess(t *testing.T){
 	mockRepo := new(repository.MockClientRepository)
-	clientService := NewClientService(mockRepo)
+	clientService := NewClientService(mockRepo, email.NewMemoryMailer())
 
 	user := &domain.Client{
 		Email: "user@email.com",
@@ -35,9 +42,30 @@ func TestRegister_Sucess(t *testing.T){
 
 }
 
+func TestRegister_SendsVerificationInLocale(t *testing.T) {
+	t.Setenv("JWT_SECRET", "segredo-de-teste")
+	mockRepo := new(repository.MockClientRepository)
+	mailer := email.NewMemoryMailer()
+	clientService := NewClientService(mockRepo, mailer)
+
+	mockRepo.On("Create", mock.AnythingOfType("*domain.Client")).Return(nil)
+
+	user := &domain.Client{Email: "user@email.com", Password: "senha123", Locale: "en-US"}
+	assert.NoError(t, clientService.Register(user))
+
+	sent := mailer.Messages()
+	assert.Equal(t, "en", user.Locale)
+	if assert.Len(t, sent, 1) {
+		assert.Equal(t, "user@email.com", sent[0].To)
+		assert.Equal(t, "✅ Confirm your email", sent[0].Subject)
+		assert.Contains(t, sent[0].Text, "/verify-email?token=")
+		assert.Contains(t, sent[0].HTML, "/verify-email?token=")
+	}
+}
+
 func TestFindByEmail_Success(t *testing.T){
 	mockRepo := new(repository.MockClientRepository)
-	authService := NewClientService(mockRepo)
+	authService := NewClientService(mockRepo, email.NewMemoryMailer())
 
 	expectedUser := &domain.Client{
 		Email: "user@email.com",
@@ -56,7 +84,7 @@ func TestFindByEmail_Success(t *testing.T){
 
 func TestFindbyEmail_NotFound(t *testing.T){
 	mockRepo := new(repository.MockClientRepository)
-	authService := NewClientService(mockRepo)
+	authService := NewClientService(mockRepo, email.NewMemoryMailer())
 
 	mockRepo.On("FindByEmail", "naoexiste@email.com").Return(nil, errors.New("usuario nao encontrado"))
 
@@ -66,4 +94,243 @@ func TestFindbyEmail_NotFound(t *testing.T){
 	assert.Equal(t, "usuário não encontrado", err.Error())
 
 	mockRepo.AssertExpectations(t)
-}
\ No newline at end of file
+}
+func TestUpdateProfile_Success(t *testing.T) {
+	mockRepo := new(repository.MockClientRepository)
+	clientService := NewClientService(mockRepo, email.NewMemoryMailer())
+
+	user := &domain.Client{ID: 1, Name: "João", Email: "user@email.com", TimeZone: "America/Sao_Paulo"}
+	mockRepo.On("FindByID", uint(1)).Return(user, nil)
+	mockRepo.On("UpdateUser", user).Return(nil)
+
+	name, phone, zone := "  João Silva ", "+5511999999999", "America/Manaus"
+	updated, err := clientService.UpdateProfile(1, &ProfileUpdateRequest{Name: &name, Phone: &phone, TimeZone: &zone})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "João Silva", updated.Name)
+	assert.Equal(t, phone, updated.Phone)
+	assert.Equal(t, zone, updated.TimeZone)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateProfile_InvalidPhone(t *testing.T) {
+	mockRepo := new(repository.MockClientRepository)
+	clientService := NewClientService(mockRepo, email.NewMemoryMailer())
+
+	mockRepo.On("FindByID", uint(1)).Return(&domain.Client{ID: 1, Name: "João"}, nil)
+
+	phone := "abc"
+	_, err := clientService.UpdateProfile(1, &ProfileUpdateRequest{Phone: &phone})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidProfile)
+	mockRepo.AssertNotCalled(t, "UpdateUser", mock.Anything)
+}
+
+func TestChangePassword_WrongPassword(t *testing.T) {
+	mockRepo := new(repository.MockClientRepository)
+	clientService := NewClientService(mockRepo, email.NewMemoryMailer())
+
+	mockRepo.On("FindByID", uint(1)).Return(&domain.Client{ID: 1, Password: hashPassword("senha123")}, nil)
+
+	err := clientService.ChangePassword(1, &ChangePasswordRequest{CurrentPassword: "errada", NewPassword: "novaSenha123"})
+
+	assert.ErrorIs(t, err, auth.ErrWrongPassword)
+	mockRepo.AssertNotCalled(t, "UpdateUser", mock.Anything)
+}
+
+func TestConfirmEmailChange_Success(t *testing.T) {
+	mockRepo := new(repository.MockClientRepository)
+	clientService := NewClientService(mockRepo, email.NewMemoryMailer())
+
+	user := &domain.Client{
+		ID:                1,
+		Email:             "antigo@email.com",
+		PendingEmail:      "novo@email.com",
+		EmailChangeToken:  auth.HashToken("token-123"),
+		EmailChangeExpiry: time.Now().Add(time.Hour),
+	}
+	mockRepo.On("FindByEmailChangeToken", auth.HashToken("token-123")).Return(user, nil)
+	mockRepo.On("FindByEmail", "novo@email.com").Return(nil, gorm.ErrRecordNotFound)
+	mockRepo.On("UpdateUser", user).Return(nil)
+
+	updated, err := clientService.ConfirmEmailChange("token-123")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "novo@email.com", updated.Email)
+	assert.Empty(t, updated.PendingEmail)
+	assert.Empty(t, updated.EmailChangeToken)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestConfirmEmailChange_Expired(t *testing.T) {
+	mockRepo := new(repository.MockClientRepository)
+	clientService := NewClientService(mockRepo, email.NewMemoryMailer())
+
+	user := &domain.Client{
+		ID:                1,
+		Email:             "antigo@email.com",
+		PendingEmail:      "novo@email.com",
+		EmailChangeToken:  auth.HashToken("token-123"),
+		EmailChangeExpiry: time.Now().Add(-time.Minute),
+	}
+	mockRepo.On("FindByEmailChangeToken", auth.HashToken("token-123")).Return(user, nil)
+
+	_, err := clientService.ConfirmEmailChange("token-123")
+
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	assert.Equal(t, "antigo@email.com", user.Email)
+	mockRepo.AssertNotCalled(t, "UpdateUser", mock.Anything)
+}
+
+func TestDeleteAccount_RequiresPassword(t *testing.T) {
+	mockRepo := new(repository.MockClientRepository)
+	clientService := NewClientService(mockRepo, email.NewMemoryMailer())
+
+	mockRepo.On("FindByID", uint(1)).Return(&domain.Client{ID: 1, Password: hashPassword("senha123")}, nil)
+	mockRepo.On("DeleteAccount", uint(1), mock.AnythingOfType("time.Time")).Return(nil).Once()
+
+	assert.ErrorIs(t, clientService.DeleteAccount(1, "errada"), auth.ErrWrongPassword)
+	assert.NoError(t, clientService.DeleteAccount(1, "senha123"))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateAddress_KeepsDefault(t *testing.T) {
+	mockRepo := new(repository.MockClientRepository)
+	clientService := NewClientService(mockRepo, email.NewMemoryMailer())
+
+	existing := &domain.SavedAddress{ID: 3, ClientID: 1, Label: "Casa", IsDefault: true}
+	mockRepo.On("FindAddress", uint(1), uint(3)).Return(existing, nil)
+	mockRepo.On("UpdateAddress", existing).Return(nil)
+
+	update := &domain.SavedAddress{
+		Label:   "Apartamento",
+		Address: geo.Address{Street: "Av. Paulista", Number: "1000", City: "São Paulo", State: "sp", PostalCode: "01310-100"},
+	}
+	address, err := clientService.UpdateAddress(1, 3, update)
+
+	assert.NoError(t, err)
+	assert.True(t, address.IsDefault)
+	assert.Equal(t, "SP", address.Address.State)
+	assert.Equal(t, "01310100", address.Address.PostalCode)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestForgotPassword_UnknownEmail(t *testing.T) {
+	mockRepo := new(repository.MockClientRepository)
+	clientService := NewClientService(mockRepo, email.NewMemoryMailer())
+
+	mockRepo.On("FindByEmail", "naoexiste@email.com").Return(nil, gorm.ErrRecordNotFound)
+
+	message, err := clientService.ForgotPassword("naoexiste@email.com")
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, message)
+	mockRepo.AssertNotCalled(t, "UpdateUser", mock.Anything)
+}
+
+func TestForgotPassword_EmailsTokenMatchingStoredHash(t *testing.T) {
+	mockRepo := new(repository.MockClientRepository)
+	mailer := email.NewMemoryMailer()
+	clientService := NewClientService(mockRepo, mailer)
+
+	user := &domain.Client{ID: 1, Email: "user@email.com"}
+	mockRepo.On("FindByEmail", "user@email.com").Return(user, nil)
+	mockRepo.On("UpdateUser", user).Return(nil).Once()
+
+	_, err := clientService.ForgotPassword("user@email.com")
+
+	assert.NoError(t, err)
+	// O e-mail sai em segundo plano
+	assert.Eventually(t, func() bool { return len(mailer.Messages()) == 1 }, time.Second, 5*time.Millisecond)
+	sent := mailer.Messages()
+	if assert.Len(t, sent, 1) {
+		assert.Equal(t, "🔑 Redefinição de Senha", sent[0].Subject)
+		token := strings.TrimSpace(strings.SplitN(strings.SplitN(sent[0].Text, "Token: ", 2)[1], "\n", 2)[0])
+		assert.Equal(t, user.ResetToken, auth.HashToken(token))
+	}
+}
+
+type failingMailer struct {
+	attempts chan email.Message
+}
+
+func (m *failingMailer) Send(ctx context.Context, msg email.Message) error {
+	m.attempts <- msg
+	return errors.New("servidor SMTP indisponível")
+}
+
+func TestForgotPassword_SameResponseWhenSendFails(t *testing.T) {
+	mockRepo := new(repository.MockClientRepository)
+	mailer := &failingMailer{attempts: make(chan email.Message, 1)}
+	clientService := NewClientService(mockRepo, mailer)
+
+	mockRepo.On("FindByEmail", "user@email.com").Return(&domain.Client{ID: 1, Email: "user@email.com"}, nil)
+	mockRepo.On("FindByEmail", "naoexiste@email.com").Return(nil, gorm.ErrRecordNotFound)
+	mockRepo.On("UpdateUser", mock.AnythingOfType("*domain.Client")).Return(nil).Once()
+
+	registered, err := clientService.ForgotPassword("user@email.com")
+	assert.NoError(t, err)
+	unknown, err := clientService.ForgotPassword("naoexiste@email.com")
+	assert.NoError(t, err)
+
+	assert.Equal(t, unknown, registered)
+	select {
+	case msg := <-mailer.attempts:
+		assert.Equal(t, "user@email.com", msg.To)
+	case <-time.After(time.Second):
+		t.Fatal("o e-mail de redefinição não foi enviado")
+	}
+}
+
+func TestResetPassword_StoresHashedTokenLookup(t *testing.T) {
+	mockRepo := new(repository.MockClientRepository)
+	clientService := NewClientService(mockRepo, email.NewMemoryMailer())
+
+	var stored string
+	mockRepo.On("ResetPassword", auth.HashToken("token-123"), mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).
+		Run(func(args mock.Arguments) { stored = args.String(1) }).
+		Return(nil).Once()
+
+	err := clientService.ResetPassword("token-123", "novaSenha123")
+
+	assert.NoError(t, err)
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(stored), []byte("novaSenha123")))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestResetPassword_Invalid(t *testing.T) {
+	mockRepo := new(repository.MockClientRepository)
+	clientService := NewClientService(mockRepo, email.NewMemoryMailer())
+
+	mockRepo.On("ResetPassword", auth.HashToken("usado"), mock.Anything, mock.Anything).Return(auth.ErrInvalidToken)
+
+	assert.ErrorIs(t, clientService.ResetPassword("", "novaSenha123"), auth.ErrInvalidToken)
+	assert.ErrorIs(t, clientService.ResetPassword("token-123", "curta"), auth.ErrInvalidPassword)
+	assert.ErrorIs(t, clientService.ResetPassword("usado", "novaSenha123"), auth.ErrInvalidToken)
+}
+
+func TestVerifyEmail_RejectsOldAddress(t *testing.T) {
+	mockRepo := new(repository.MockClientRepository)
+	clientService := NewClientService(mockRepo, email.NewMemoryMailer())
+
+	user := &domain.Client{ID: 1, Email: "novo@email.com"}
+	mockRepo.On("FindByID", uint(1)).Return(user, nil)
+	mockRepo.On("UpdateUser", user).Return(nil).Once()
+
+	assert.ErrorIs(t, clientService.VerifyEmail(1, "antigo@email.com"), auth.ErrInvalidVerificationToken)
+	assert.Nil(t, user.EmailVerifiedAt)
+
+	assert.NoError(t, clientService.VerifyEmail(1, "novo@email.com"))
+	assert.NotNil(t, user.EmailVerifiedAt)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestResendVerification_TooSoon(t *testing.T) {
+	mockRepo := new(repository.MockClientRepository)
+	clientService := NewClientService(mockRepo, email.NewMemoryMailer())
+
+	mockRepo.On("FindByID", uint(1)).Return(&domain.Client{ID: 1, VerificationSentAt: time.Now()}, nil)
+
+	assert.ErrorIs(t, clientService.ResendVerification(1), auth.ErrResendTooSoon)
+	mockRepo.AssertNotCalled(t, "UpdateUser", mock.Anything)
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"1mao/internal/client/domain"
+	"1mao/pkg/auth"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// sendVerification assina o link de verificação para o e-mail atual do
+// cliente e o envia
+func (s *clientService) sendVerification(user *domain.Client) error {
+	token, err := auth.IssueEmailVerificationToken(user.ID, auth.RoleUser, user.Email)
+	if err != nil {
+		return err
+	}
+	return s.sendVerificationEmail(user, token)
+}
+
+// 🔹 Confirma o e-mail a partir do link; repetir a confirmação não tem efeito
+func (s *clientService) VerifyEmail(clientID uint, email string) error {
+	user, err := s.findClient(clientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrClientNotFound) {
+			return auth.ErrInvalidVerificationToken
+		}
+		return err
+	}
+	// O link é de um e-mail que não é mais o da conta
+	if user.Email != email {
+		return auth.ErrInvalidVerificationToken
+	}
+	if user.EmailVerifiedAt != nil {
+		return nil
+	}
+
+	now := time.Now()
+	user.EmailVerifiedAt = &now
+	return s.userRepo.UpdateUser(user)
+}
+
+// 🔹 Reenvia o link de verificação, no máximo uma vez por
+// auth.VerificationResendInterval
+func (s *clientService) ResendVerification(clientID uint) error {
+	user, err := s.findClient(clientID)
+	if err != nil {
+		return err
+	}
+	if user.EmailVerifiedAt != nil {
+		return domain.ErrAlreadyVerified
+	}
+	now := time.Now()
+	if now.Sub(user.VerificationSentAt) < auth.VerificationResendInterval {
+		return auth.ErrResendTooSoon
+	}
+
+	user.VerificationSentAt = now
+	if err := s.userRepo.UpdateUser(user); err != nil {
+		return err
+	}
+	if err := s.sendVerification(user); err != nil {
+		return fmt.Errorf("erro ao enviar email: %w", err)
+	}
+	return nil
+}
@@ -5,5 +5,7 @@ type Notification struct {
 	ID         int   `json:"id"`
 	SenderID   int   `json:"sender_id"`
 	ReceiverID int   `json:"receiver_id"`
+	// Tipo do destinatário (client ou professional), usado pelo hub para rotear
+	ReceiverType string `json:"receiver_type"`
 	Content string `json:"content"`
 }
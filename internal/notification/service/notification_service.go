@@ -18,6 +18,7 @@ func (s *NotificationService) SendNotification(notification domain.Notification)
     msg := domain.Message{
         SenderID:   notification.SenderID,
         ReceiverID: notification.ReceiverID,
+        ReceiverType: notification.ReceiverType,
         Content:    notification.Content,
     }
 
@@ -1,15 +1,20 @@
 package httpa
 
 import (
+	"1mao/internal/middleware"
+	"1mao/internal/payment/domain"
 	"1mao/internal/payment/dtos"
 	"1mao/internal/payment/service"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
 	"os"
+	"strconv"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/gorilla/mux"
 	"github.com/stripe/stripe-go/v81"
 )
@@ -32,10 +37,13 @@ func NewPaymentHandler(paymentService service.PaymentService) *PaymentHandler {
 // @Param   Authorization   header  string  true  "Token de autenticação (Bearer token)"//	@Produce		json
 //	@Success		200	{object}	dtos.CreatePaymentRequest
 //	@Failure		401	{object}	map[string]string	"Não autorizado"
+//	@Failure		403	{object}	map[string]string	"E-mail não verificado ou pagamento de outro cliente"
 //	@Router			/clients/{client_id}/payments" [post]
 func (h *PaymentHandler) CreatePayment(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	clientID := vars["client_id"]
+	clientID, ok := authorizedClientID(w, r)
+	if !ok {
+		return
+	}
 
 	var req dtos.CreatePaymentRequest
 
@@ -50,6 +58,10 @@ func (h *PaymentHandler) CreatePayment(w http.ResponseWriter, r *http.Request) {
 	}
 
 	transaction, err := h.paymentService.CreatePayment(clientID, req.BookingID, req.Amount, req.Method)
+	if errors.Is(err, domain.ErrEmailNotVerified) {
+		http.Error(w, "confirme seu e-mail antes de pagar", http.StatusForbidden)
+		return
+	}
 	if err != nil {
 		http.Error(w, "falha ao criar pagamento", http.StatusInternalServerError)
 		return
@@ -140,8 +152,10 @@ func (h *PaymentHandler) GetPaymentStatus(w http.ResponseWriter, r *http.Request
 }
 
 func (h *PaymentHandler) GetClientPayments(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	clientID := vars["client_id"]
+	clientID, ok := authorizedClientID(w, r)
+	if !ok {
+		return
+	}
 
 	payments, err := h.paymentService.GetClientPayments(clientID)
 	if err != nil {
@@ -153,3 +167,24 @@ func (h *PaymentHandler) GetClientPayments(w http.ResponseWriter, r *http.Reques
 	json.NewEncoder(w).Encode(payments)
 
 }
+
+// authorizedClientID devolve o client_id da rota quando ele é o do cliente
+// autenticado; caso contrário responde 401/403 e devolve false
+func authorizedClientID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	clientID := mux.Vars(r)["client_id"]
+	claims, ok := r.Context().Value(middleware.UserContextKey).(jwt.MapClaims)
+	if !ok {
+		http.Error(w, "usuário não autenticado", http.StatusUnauthorized)
+		return "", false
+	}
+	userID, ok := claims["user_id"].(float64)
+	if !ok {
+		http.Error(w, "usuário não autenticado", http.StatusUnauthorized)
+		return "", false
+	}
+	if clientID != strconv.FormatUint(uint64(userID), 10) {
+		http.Error(w, "acesso negado", http.StatusForbidden)
+		return "", false
+	}
+	return clientID, true
+}
@@ -1,6 +1,15 @@
 package domain
 
-import _ "gorm.io/gorm"
+import (
+	"errors"
+
+	_ "gorm.io/gorm"
+)
+
+var (
+	ErrTransactionNotFound = errors.New("transaction not found")
+	ErrEmailNotVerified    = errors.New("email not verified")
+)
 
 type Status string
 
@@ -9,6 +18,21 @@ const (
 	StatusPaid     Status = "paid"
 	StatusFailed   Status = "failed"
 	StatusRefunded Status = "refunded"
+	// Reembolso parcial: multa de cancelamento retida
+	StatusPartiallyRefunded Status = "partially_refunded"
+	// Cobrança pendente cancelada antes do pagamento
+	StatusCancelled Status = "cancelled"
+)
+
+// Kind distingue a cobrança do agendamento das multas cobradas depois dele.
+// Um agendamento tem no máximo uma transação de cada tipo.
+type Kind string
+
+const (
+	// Cobrança original do agendamento
+	KindCharge Kind = "charge"
+	// Multa de cancelamento ou não comparecimento sem cobrança original
+	KindFee Kind = "fee"
 )
 
 //	 Transaction representa um transação de serviço
@@ -17,11 +41,22 @@ const (
 //		@model			Transaction
 type Transaction struct {
 	ID            string `json:"id" gorm:"primaryKey"`
-	BookingID     string `json:"booking_id" gorm:"unique;not null"`
+	BookingID     string `json:"booking_id" gorm:"not null;uniqueIndex:idx_transactions_booking_kind"`
+	Kind          Kind   `json:"kind" gorm:"not null;default:charge;uniqueIndex:idx_transactions_booking_kind"`
 	ClientID      string `json:"client_id"`
 	Amount        int64  `json:"amount" gorm:"not null"`
 	Currency      string `json:"currency" gorm:"not null"`
 	Status        Status `json:"status" gorm:"not null"`
 	PaymentMethod string `json:"payment_method" gorm:"not null"`
 	GatewayID     string `json:"gateway_id" gorm:"not null"`
+	// Valor já devolvido ao cliente, em centavos
+	RefundedAmount int64 `json:"refunded_amount"`
+}
+
+// Payer reúne os dados do cliente usados no recibo
+type Payer struct {
+	Name     string
+	Email    string
+	Locale   string
+	TimeZone string
 }
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"1mao/internal/payment/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockPaymentRepository struct {
+	mock.Mock
+}
+
+func (m *MockPaymentRepository) CreateTransaction(transaction domain.Transaction) error {
+	args := m.Called(transaction)
+	return args.Error(0)
+}
+
+func (m *MockPaymentRepository) GetByGatewayID(gatewayID string) (*domain.Transaction, error) {
+	args := m.Called(gatewayID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Transaction), args.Error(1)
+}
+
+func (m *MockPaymentRepository) UpdateStatus(transactionID string, status string) error {
+	args := m.Called(transactionID, status)
+	return args.Error(0)
+}
+
+func (m *MockPaymentRepository) GetByID(id string) (*domain.Transaction, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Transaction), args.Error(1)
+}
+
+func (m *MockPaymentRepository) GetByClientID(clientID string) ([]domain.Transaction, error) {
+	args := m.Called(clientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Transaction), args.Error(1)
+}
+
+func (m *MockPaymentRepository) GetChargeByBookingID(bookingID string) (*domain.Transaction, error) {
+	args := m.Called(bookingID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Transaction), args.Error(1)
+}
+
+func (m *MockPaymentRepository) Save(transaction *domain.Transaction) error {
+	args := m.Called(transaction)
+	return args.Error(0)
+}
+
+func (m *MockPaymentRepository) ClientEmailVerified(clientID string) (bool, error) {
+	args := m.Called(clientID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockPaymentRepository) Payer(clientID string) (*domain.Payer, error) {
+	args := m.Called(clientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Payer), args.Error(1)
+}
@@ -1,7 +1,9 @@
 package repository
 
 import (
+	client "1mao/internal/client/domain"
 	"1mao/internal/payment/domain"
+	"errors"
 
 	"gorm.io/gorm"
 )
@@ -12,6 +14,10 @@ type PaymentRepository interface {
 	UpdateStatus(transactionID string, status string) error
 	GetByID(id string) (*domain.Transaction, error)
 	GetByClientID(clientID string) ([]domain.Transaction, error)
+	GetChargeByBookingID(bookingID string) (*domain.Transaction, error)
+	Save(transaction *domain.Transaction) error
+	ClientEmailVerified(clientID string) (bool, error)
+	Payer(clientID string) (*domain.Payer, error)
 }
 
 type paymentRepository struct {
@@ -24,7 +30,7 @@ func NewPaymentRepository(db *gorm.DB) PaymentRepository {
 
 // CreateTransaction implements PaymentRepository.
 func (p *paymentRepository) CreateTransaction(transaction domain.Transaction) error {
-	return p.db.Create(transaction).Error
+	return p.db.Create(&transaction).Error
 }
 
 func (p *paymentRepository) GetByGatewayID(gatewayID string) (*domain.Transaction, error) {
@@ -50,3 +56,41 @@ func (r *paymentRepository) GetByClientID(clientID string) ([]domain.Transaction
     err := r.db.Where("client_id = ?", clientID).Find(&transactions).Error
     return transactions, err
 }
+
+// GetChargeByBookingID busca a cobrança original do agendamento, ignorando
+// multas; retorna domain.ErrTransactionNotFound se não houver
+func (r *paymentRepository) GetChargeByBookingID(bookingID string) (*domain.Transaction, error) {
+	var transaction domain.Transaction
+	err := r.db.Where("booking_id = ? AND kind = ?", bookingID, domain.KindCharge).First(&transaction).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domain.ErrTransactionNotFound
+	}
+	return &transaction, err
+}
+
+func (r *paymentRepository) Save(transaction *domain.Transaction) error {
+	return r.db.Save(transaction).Error
+}
+
+// ClientEmailVerified informa se o cliente já confirmou o e-mail; cliente
+// inexistente ou excluído conta como não verificado
+func (r *paymentRepository) ClientEmailVerified(clientID string) (bool, error) {
+	var count int64
+	err := r.db.Model(&client.Client{}).
+		Where("id = ? AND email_verified_at IS NOT NULL", clientID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// Payer busca os dados do cliente para o recibo; nil se o cliente não existir
+func (r *paymentRepository) Payer(clientID string) (*domain.Payer, error) {
+	var c client.Client
+	err := r.db.Select("id", "name", "email", "locale", "time_zone").Where("id = ?", clientID).First(&c).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Payer{Name: c.Name, Email: c.Email, Locale: c.Locale, TimeZone: c.TimeZone}, nil
+}
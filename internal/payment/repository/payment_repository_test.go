@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"1mao/internal/payment/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// Teste de integração: requer um Postgres acessível em TEST_DATABASE_DSN
+// (ex: "host=localhost user=postgres password=postgres dbname=1mao_test sslmode=disable")
+func TestGetChargeByBookingID_IgnoresFees(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN não definido")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&domain.Transaction{}))
+	repo := NewPaymentRepository(db)
+
+	suffix := time.Now().UnixNano()
+	bookingID := fmt.Sprintf("booking-%d", suffix)
+	// A multa é gravada primeiro para que a busca não dependa da ordem física
+	require.NoError(t, repo.CreateTransaction(domain.Transaction{
+		ID: fmt.Sprintf("fee-%d", suffix), BookingID: bookingID, Kind: domain.KindFee,
+		Amount: 2000, Currency: "BRL", Status: domain.StatusPending, PaymentMethod: "card", GatewayID: "pi_fee",
+	}))
+	require.NoError(t, repo.CreateTransaction(domain.Transaction{
+		ID: fmt.Sprintf("charge-%d", suffix), BookingID: bookingID, Kind: domain.KindCharge,
+		Amount: 10000, Currency: "BRL", Status: domain.StatusPaid, PaymentMethod: "card", GatewayID: "pi_charge",
+	}))
+
+	charge, err := repo.GetChargeByBookingID(bookingID)
+
+	require.NoError(t, err)
+	assert.Equal(t, "pi_charge", charge.GatewayID)
+
+	_, err = repo.GetChargeByBookingID(fmt.Sprintf("missing-%d", suffix))
+	assert.ErrorIs(t, err, domain.ErrTransactionNotFound)
+}
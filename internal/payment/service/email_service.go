@@ -0,0 +1,45 @@
+package service
+
+import (
+	"1mao/pkg/email"
+	"1mao/pkg/timezone"
+	"context"
+	"time"
+)
+
+// sendReceipt envia em segundo plano o recibo do pagamento confirmado ao
+// cliente. Falhas só são registradas: o webhook do Stripe não deve esperar
+// nem ser reenviado por causa do e-mail.
+func (s *paymentService) sendReceipt(gatewayID string) {
+	if s.mailer == nil {
+		return
+	}
+	paidAt := time.Now()
+	email.SendAsync(context.Background(), "recibo do pagamento "+gatewayID, func(ctx context.Context) error {
+		return s.receipt(ctx, gatewayID, paidAt)
+	})
+}
+
+func (s *paymentService) receipt(ctx context.Context, gatewayID string, paidAt time.Time) error {
+	transaction, err := s.repo.GetByGatewayID(gatewayID)
+	if err != nil {
+		return err
+	}
+	payer, err := s.repo.Payer(transaction.ClientID)
+	if err != nil || payer == nil {
+		return err
+	}
+	loc, err := timezone.Load(payer.TimeZone)
+	if err != nil {
+		return err
+	}
+
+	return email.SendTemplate(ctx, s.mailer, payer.Email, payer.Locale, email.TemplatePaymentReceipt, email.PaymentReceiptData{
+		Name:          payer.Name,
+		TransactionID: transaction.ID,
+		BookingID:     transaction.BookingID,
+		AmountCents:   transaction.Amount,
+		Currency:      transaction.Currency,
+		PaidAt:        paidAt.In(loc),
+	})
+}
@@ -3,6 +3,8 @@ package service
 import (
 	"1mao/internal/payment/domain"
 	"1mao/internal/payment/repository"
+	"1mao/pkg/email"
+	"errors"
 	"log"
 
 	"github.com/google/uuid"
@@ -14,21 +16,41 @@ type PaymentService interface {
 	FailPayment(gatewayID string) error
 	GetPaymentByID(paymentID string) (*domain.Transaction, error)
 	GetClientPayments(clientID string) ([]domain.Transaction, error)
+	SettleCancellation(clientID string, bookingID string, fee int64) (*domain.Transaction, error)
 }
 
 type paymentService struct {
 	repo   repository.PaymentRepository
 	stripe *StripeClient
+	mailer email.Mailer
 }
 
-func NewPaymentService(repo repository.PaymentRepository, stripeKey string) PaymentService {
+// NewPaymentService cria o serviço de pagamentos; mailer envia os recibos e
+// pode ser nil
+func NewPaymentService(repo repository.PaymentRepository, stripeKey string, mailer email.Mailer) PaymentService {
 	return &paymentService{
 		repo:   repo,
 		stripe: NewStripeClient(stripeKey),
+		mailer: mailer,
 	}
 }
 
+// CreatePayment cobra um agendamento a pedido do cliente, que precisa ter o
+// e-mail confirmado
 func (s *paymentService) CreatePayment(clientID string, bookingID string, amount int64, method string) (*domain.Transaction, error) {
+	verified, err := s.repo.ClientEmailVerified(clientID)
+	if err != nil {
+		return nil, err
+	}
+	if !verified {
+		return nil, domain.ErrEmailNotVerified
+	}
+	return s.createPayment(clientID, bookingID, domain.KindCharge, amount, method)
+}
+
+// createPayment cria a cobrança sem checar a conta; usado também pela multa
+// de cancelamento, que não depende da verificação do cliente
+func (s *paymentService) createPayment(clientID string, bookingID string, kind domain.Kind, amount int64, method string) (*domain.Transaction, error) {
 	// criar intent no stripe
 	intent, err := s.stripe.CreatePaymentIntent(amount, "brl")
 	if err != nil {
@@ -38,6 +60,7 @@ func (s *paymentService) CreatePayment(clientID string, bookingID string, amount
 	transaction := domain.Transaction{
 		ID:            uuid.NewString(),
 		BookingID:     bookingID,
+		Kind:          kind,
 		ClientID:      clientID,
 		Amount:        amount,
 		Currency:      "BRL",
@@ -52,7 +75,11 @@ func (s *paymentService) CreatePayment(clientID string, bookingID string, amount
 
 func (s *paymentService) ConfirmPayment(gatewayID string) error {
 	log.Printf("Confirmando pagamento: %s", gatewayID)
-	return s.repo.UpdateStatus(gatewayID, string(domain.StatusPaid))
+	if err := s.repo.UpdateStatus(gatewayID, string(domain.StatusPaid)); err != nil {
+		return err
+	}
+	s.sendReceipt(gatewayID)
+	return nil
 }
 
 func (s *paymentService) FailPayment(gatewayID string) error {
@@ -67,3 +94,54 @@ func (s *paymentService) GetPaymentByID(paymentID string) (*domain.Transaction,
 func (s *paymentService) GetClientPayments(clientID string) ([]domain.Transaction, error) {
 	return s.repo.GetByClientID(clientID)
 }
+
+// SettleCancellation acerta o pagamento de um agendamento cancelado, retendo
+// fee centavos de multa: reembolsa o restante se já estava pago, ajusta a
+// cobrança pendente ou, sem pagamento algum, cobra apenas a multa.
+func (s *paymentService) SettleCancellation(clientID string, bookingID string, fee int64) (*domain.Transaction, error) {
+	transaction, err := s.repo.GetChargeByBookingID(bookingID)
+	if errors.Is(err, domain.ErrTransactionNotFound) {
+		if fee <= 0 {
+			return nil, nil
+		}
+		log.Printf("Cobrando multa de cancelamento do agendamento %s: %d", bookingID, fee)
+		return s.createPayment(clientID, bookingID, domain.KindFee, fee, "card")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch transaction.Status {
+	case domain.StatusPaid:
+		refund := transaction.Amount - fee
+		if refund <= 0 {
+			return transaction, nil
+		}
+		log.Printf("Reembolsando agendamento %s: %d", bookingID, refund)
+		if _, err := s.stripe.CreateRefund(transaction.GatewayID, refund); err != nil {
+			return nil, err
+		}
+		transaction.RefundedAmount = refund
+		transaction.Status = domain.StatusRefunded
+		if refund < transaction.Amount {
+			transaction.Status = domain.StatusPartiallyRefunded
+		}
+	case domain.StatusPending:
+		if fee <= 0 {
+			if _, err := s.stripe.CancelPaymentIntent(transaction.GatewayID); err != nil {
+				return nil, err
+			}
+			transaction.Status = domain.StatusCancelled
+		} else {
+			if _, err := s.stripe.UpdatePaymentIntentAmount(transaction.GatewayID, fee); err != nil {
+				return nil, err
+			}
+			transaction.Amount = fee
+		}
+	default:
+		// Falhou ou já foi reembolsado: nada a acertar
+		return transaction, nil
+	}
+
+	return transaction, s.repo.Save(transaction)
+}
@@ -0,0 +1,117 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"1mao/internal/payment/domain"
+	"1mao/internal/payment/repository"
+	"1mao/internal/payment/service"
+	"1mao/pkg/email"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentService_CreatePayment_EmailVerification(t *testing.T) {
+	t.Run("Error - unverified client cannot pay", func(t *testing.T) {
+		mockRepo := new(repository.MockPaymentRepository)
+		paymentService := service.NewPaymentService(mockRepo, "", nil)
+		mockRepo.On("ClientEmailVerified", "7").Return(false, nil).Once()
+
+		transaction, err := paymentService.CreatePayment("7", "3", 15000, "card")
+
+		assert.Nil(t, transaction)
+		assert.ErrorIs(t, err, domain.ErrEmailNotVerified)
+		mockRepo.AssertNotCalled(t, "CreateTransaction", mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - lookup failure is returned", func(t *testing.T) {
+		mockRepo := new(repository.MockPaymentRepository)
+		paymentService := service.NewPaymentService(mockRepo, "", nil)
+		mockRepo.On("ClientEmailVerified", "7").Return(false, assert.AnError).Once()
+
+		transaction, err := paymentService.CreatePayment("7", "3", 15000, "card")
+
+		assert.Nil(t, transaction)
+		assert.Equal(t, assert.AnError, err)
+		mockRepo.AssertNotCalled(t, "CreateTransaction", mock.Anything)
+	})
+}
+
+func TestPaymentService_SettleCancellation_SkipsEmailCheck(t *testing.T) {
+	// Sem multa não há cobrança: a liquidação não consulta a verificação
+	mockRepo := new(repository.MockPaymentRepository)
+	paymentService := service.NewPaymentService(mockRepo, "", nil)
+	mockRepo.On("GetChargeByBookingID", "3").Return(nil, domain.ErrTransactionNotFound).Once()
+
+	transaction, err := paymentService.SettleCancellation("7", "3", 0)
+
+	assert.NoError(t, err)
+	assert.Nil(t, transaction)
+	mockRepo.AssertNotCalled(t, "ClientEmailVerified", mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPaymentService_ConfirmPayment_Receipt(t *testing.T) {
+	transaction := &domain.Transaction{ID: "tx-1", BookingID: "9", ClientID: "7", Amount: 123456, Currency: "BRL", GatewayID: "pi_123"}
+
+	t.Run("Success - receipt in the payer's locale", func(t *testing.T) {
+		mockRepo := new(repository.MockPaymentRepository)
+		mailer := email.NewMemoryMailer()
+		paymentService := service.NewPaymentService(mockRepo, "", mailer)
+		mockRepo.On("UpdateStatus", "pi_123", string(domain.StatusPaid)).Return(nil).Once()
+		mockRepo.On("GetByGatewayID", "pi_123").Return(transaction, nil).Once()
+		mockRepo.On("Payer", "7").Return(&domain.Payer{
+			Name: "Ana", Email: "ana@email.com", Locale: "en-US", TimeZone: "America/Sao_Paulo",
+		}, nil).Once()
+
+		err := paymentService.ConfirmPayment("pi_123")
+
+		require.NoError(t, err)
+		// O recibo sai em segundo plano
+		require.Eventually(t, func() bool { return len(mailer.Messages()) == 1 }, time.Second, 5*time.Millisecond)
+		sent := mailer.Messages()[0]
+		assert.Equal(t, "ana@email.com", sent.To)
+		assert.Equal(t, "🧾 Payment receipt", sent.Subject)
+		assert.Contains(t, sent.Text, "R$ 1,234.56")
+		assert.Contains(t, sent.Text, "booking #9")
+		assert.Contains(t, sent.Text, "tx-1")
+		assert.Contains(t, sent.HTML, "Ana")
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Success - payer without account gets no receipt", func(t *testing.T) {
+		mockRepo := new(repository.MockPaymentRepository)
+		mailer := email.NewMemoryMailer()
+		paymentService := service.NewPaymentService(mockRepo, "", mailer)
+		mockRepo.On("UpdateStatus", "pi_123", string(domain.StatusPaid)).Return(nil).Once()
+		mockRepo.On("GetByGatewayID", "pi_123").Return(transaction, nil).Once()
+		looked := make(chan struct{})
+		mockRepo.On("Payer", "7").Run(func(mock.Arguments) { close(looked) }).Return(nil, nil).Once()
+
+		require.NoError(t, paymentService.ConfirmPayment("pi_123"))
+
+		select {
+		case <-looked:
+		case <-time.After(time.Second):
+			t.Fatal("o recibo não procurou o pagador")
+		}
+		assert.Empty(t, mailer.Messages())
+	})
+
+	t.Run("Error - status update failure sends nothing", func(t *testing.T) {
+		mockRepo := new(repository.MockPaymentRepository)
+		mailer := email.NewMemoryMailer()
+		paymentService := service.NewPaymentService(mockRepo, "", mailer)
+		mockRepo.On("UpdateStatus", "pi_123", string(domain.StatusPaid)).Return(assert.AnError).Once()
+
+		err := paymentService.ConfirmPayment("pi_123")
+
+		assert.Equal(t, assert.AnError, err)
+		assert.Empty(t, mailer.Messages())
+		mockRepo.AssertNotCalled(t, "GetByGatewayID", mock.Anything)
+	})
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"github.com/stripe/stripe-go/v81/paymentintent"
+	"github.com/stripe/stripe-go/v81/refund"
 	"github.com/stripe/stripe-go/v81"
 )
 
@@ -26,3 +27,25 @@ func (c *StripeClient) CreatePaymentIntent(amount int64, currency string) (*stri
 	}
 	return paymentintent.New(params)
 }
+
+// CreateRefund devolve amount centavos do pagamento ao cliente
+func (c *StripeClient) CreateRefund(paymentIntentID string, amount int64) (*stripe.Refund, error) {
+	params := &stripe.RefundParams{
+		PaymentIntent: stripe.String(paymentIntentID),
+		Amount:        stripe.Int64(amount),
+	}
+	return refund.New(params)
+}
+
+// UpdatePaymentIntentAmount altera o valor de uma intent ainda não paga
+func (c *StripeClient) UpdatePaymentIntentAmount(paymentIntentID string, amount int64) (*stripe.PaymentIntent, error) {
+	params := &stripe.PaymentIntentParams{
+		Amount: stripe.Int64(amount),
+	}
+	return paymentintent.Update(paymentIntentID, params)
+}
+
+// CancelPaymentIntent cancela uma intent ainda não paga
+func (c *StripeClient) CancelPaymentIntent(paymentIntentID string) (*stripe.PaymentIntent, error) {
+	return paymentintent.Cancel(paymentIntentID, nil)
+}
@@ -0,0 +1,353 @@
+package httpa
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	"1mao/internal/professional/domain"
+	"1mao/internal/professional/service"
+	"1mao/pkg/auth"
+	"1mao/pkg/storage"
+
+	"github.com/gorilla/mux"
+)
+
+// DeleteAccountRequest confirma a exclusão da conta
+//
+//	@Description	Senha atual para confirmar a exclusão
+type DeleteAccountRequest struct {
+	Password string `json:"password" example:"senhaSegura123"`
+}
+
+// ForgotPasswordRequest pede o token de redefinição de senha
+//
+//	@Description	E-mail da conta do profissional
+type ForgotPasswordRequest struct {
+	Email string `json:"email" example:"profissional@example.com"`
+}
+
+// ResetPasswordRequest troca a senha usando o token recebido por e-mail
+//
+//	@Description	Token de redefinição e nova senha (mínimo de 8 caracteres)
+type ResetPasswordRequest struct {
+	Token       string `json:"token" example:"q3J6c2Vi..."`
+	NewPassword string `json:"new_password" example:"senhaNova456"`
+}
+
+// UpdateProfile godoc
+//
+//	@Summary		Atualizar perfil
+//	@Description	Atualiza bio, profissão, experiência e telefone do profissional autenticado. Campos omitidos não mudam.
+//	@Tags			Professionals
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header		string							true	"Token de autenticação (Bearer token)"
+//	@Param			profile			body		service.ProfileUpdateRequest	true	"Campos a atualizar"
+//	@Success		200				{object}	domain.Professional
+//	@Failure		400				{object}	map[string]string	"Dados inválidos"
+//	@Failure		401				{object}	map[string]string	"Não autorizado"
+//	@Router			/professional/profile [patch]
+func (h *ProfessionalHandler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := professionalIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req service.ProfileUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	professional, err := h.service.UpdateProfile(professionalID, &req)
+	if err != nil {
+		writeAccountError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(professional)
+}
+
+// UpdatePhoto godoc
+//
+//	@Summary		Atualizar foto de perfil
+//	@Description	Envia a foto de perfil (JPEG ou PNG de até 5 MB) do profissional autenticado, substituindo a anterior
+//	@Tags			Professionals
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header		string	true	"Token de autenticação (Bearer token)"
+//	@Param			photo			formData	file	true	"Foto de perfil"
+//	@Success		200				{object}	domain.Professional
+//	@Failure		400				{object}	map[string]string	"Foto inválida"
+//	@Failure		401				{object}	map[string]string	"Não autorizado"
+//	@Router			/professional/profile/photo [put]
+func (h *ProfessionalHandler) UpdatePhoto(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := professionalIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, domain.MaxPhotoSize+1<<20)
+	file, header, err := r.FormFile("photo")
+	if err != nil {
+		http.Error(w, "Invalid photo", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	professional, err := h.service.UpdatePhoto(professionalID, header.Size, file)
+	if err != nil {
+		writeAccountError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(professional)
+}
+
+// GetPhoto godoc
+//
+//	@Summary		Foto de perfil
+//	@Description	Retorna a foto de perfil de um profissional ativo
+//	@Tags			Professionals
+//	@Produce		image/jpeg,image/png
+//	@Param			id	path		int	true	"ID do profissional"
+//	@Success		200	{file}		file
+//	@Failure		404	{object}	map[string]string	"Foto não encontrada"
+//	@Router			/professional/{id}/photo [get]
+func (h *ProfessionalHandler) GetPhoto(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid ID", http.StatusBadRequest)
+		return
+	}
+
+	contentType, file, err := h.service.OpenPhoto(uint(id))
+	if err != nil {
+		writeAccountError(w, err)
+		return
+	}
+	defer file.Close()
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if _, err := io.Copy(w, file); err != nil {
+		log.Printf("erro ao enviar foto do profissional %d: %v", id, err)
+	}
+}
+
+// ChangePassword godoc
+//
+//	@Summary		Trocar senha
+//	@Description	Troca a senha do profissional autenticado, confirmando a senha atual
+//	@Tags			Auth
+//	@Accept			json
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header	string							true	"Token de autenticação (Bearer token)"
+//	@Param			request			body	service.ChangePasswordRequest	true	"Senha atual e nova"
+//	@Success		204
+//	@Failure		400	{object}	map[string]string	"Nova senha inválida"
+//	@Failure		403	{object}	map[string]string	"Senha atual incorreta"
+//	@Router			/professional/password [put]
+func (h *ProfessionalHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := professionalIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req service.ChangePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.ChangePassword(professionalID, &req); err != nil {
+		writeAccountError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeactivateAccount godoc
+//
+//	@Summary		Desativar conta
+//	@Description	Tira o profissional autenticado da listagem e da busca e bloqueia novos agendamentos. Os agendamentos existentes continuam valendo.
+//	@Tags			Professionals
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header	string	true	"Token de autenticação (Bearer token)"
+//	@Success		204
+//	@Failure		401	{object}	map[string]string	"Não autorizado"
+//	@Router			/professional/account/deactivate [post]
+func (h *ProfessionalHandler) DeactivateAccount(w http.ResponseWriter, r *http.Request) {
+	h.setActive(w, r, false)
+}
+
+// ReactivateAccount godoc
+//
+//	@Summary		Reativar conta
+//	@Description	Volta a exibir o profissional autenticado e a aceitar agendamentos
+//	@Tags			Professionals
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header	string	true	"Token de autenticação (Bearer token)"
+//	@Success		204
+//	@Failure		401	{object}	map[string]string	"Não autorizado"
+//	@Router			/professional/account/reactivate [post]
+func (h *ProfessionalHandler) ReactivateAccount(w http.ResponseWriter, r *http.Request) {
+	h.setActive(w, r, true)
+}
+
+func (h *ProfessionalHandler) setActive(w http.ResponseWriter, r *http.Request, active bool) {
+	professionalID, ok := professionalIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var err error
+	if active {
+		err = h.service.Reactivate(professionalID)
+	} else {
+		err = h.service.Deactivate(professionalID)
+	}
+	if err != nil {
+		writeAccountError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteAccount godoc
+//
+//	@Summary		Excluir conta
+//	@Description	Exclui a conta do profissional autenticado, apagando os dados pessoais, o catálogo e os documentos. Recusada enquanto houver agendamentos ativos por acontecer.
+//	@Tags			Professionals
+//	@Accept			json
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header	string					true	"Token de autenticação (Bearer token)"
+//	@Param			request			body	DeleteAccountRequest	true	"Confirmação da senha"
+//	@Success		204
+//	@Failure		403	{object}	map[string]string	"Senha incorreta"
+//	@Failure		409	{object}	map[string]string	"Há agendamentos ativos"
+//	@Router			/professional/account [delete]
+func (h *ProfessionalHandler) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := professionalIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req DeleteAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.DeleteAccount(professionalID, req.Password); err != nil {
+		writeAccountError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ForgotPassword godoc
+//
+//	@Summary		Esqueci minha senha (profissional)
+//	@Description	Envia um token de redefinição (válido por 1 hora) para o e-mail, se ele estiver cadastrado. A resposta é a mesma em ambos os casos.
+//	@Tags			Auth
+//	@Accept			json
+//	@Param			request	body	ForgotPasswordRequest	true	"E-mail da conta"
+//	@Success		202
+//	@Failure		400	{object}	map[string]string	"Requisição inválida"
+//	@Router			/professional/forgot-password [post]
+func (h *ProfessionalHandler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	var req ForgotPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Email == "" {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.ForgotPassword(req.Email); err != nil {
+		writeAccountError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// ResetPassword godoc
+//
+//	@Summary		Redefinir senha (profissional)
+//	@Description	Troca a senha com o token recebido por e-mail. O token vale uma única vez.
+//	@Tags			Auth
+//	@Accept			json
+//	@Param			request	body	ResetPasswordRequest	true	"Token e nova senha"
+//	@Success		204
+//	@Failure		400	{object}	map[string]string	"Token inválido ou expirado, ou senha inválida"
+//	@Router			/professional/reset-password [post]
+func (h *ProfessionalHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var req ResetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.ResetPassword(req.Token, req.NewPassword); err != nil {
+		writeAccountError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ResendVerification godoc
+//
+//	@Summary		Reenviar verificação de e-mail (profissional)
+//	@Description	Reenvia o link de verificação para o e-mail do profissional autenticado (no máximo uma vez por minuto)
+//	@Tags			Professionals
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header	string	true	"Token de autenticação (Bearer token)"
+//	@Success		202
+//	@Failure		409	{object}	map[string]string	"E-mail já verificado"
+//	@Failure		429	{object}	map[string]string	"Reenvio recente"
+//	@Router			/professional/resend-verification [post]
+func (h *ProfessionalHandler) ResendVerification(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := professionalIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.service.ResendVerification(r.Context(), professionalID); err != nil {
+		writeAccountError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// writeAccountError traduz os erros do perfil e da conta em status HTTP
+func writeAccountError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrInvalidProfile), errors.Is(err, auth.ErrInvalidPassword),
+		errors.Is(err, domain.ErrInvalidPhoto), errors.Is(err, auth.ErrInvalidToken):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, auth.ErrWrongPassword):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, domain.ErrProfessionalNotFound), errors.Is(err, storage.ErrNotFound):
+		http.Error(w, "Professional not found", http.StatusNotFound)
+	case errors.Is(err, domain.ErrActiveBookings), errors.Is(err, domain.ErrEmailAlreadyVerified):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, auth.ErrResendTooSoon):
+		w.Header().Set("Retry-After", strconv.Itoa(int(auth.VerificationResendInterval.Seconds())))
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+	default:
+		http.Error(w, "Error updating account", http.StatusInternalServerError)
+	}
+}
@@ -2,23 +2,54 @@ package httpa
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"net/url"
 	"strconv"
 
 	"1mao/internal/professional/domain"
 	"1mao/internal/professional/service"
+	"1mao/pkg/geo"
+	"1mao/pkg/timezone"
 
 	"github.com/gorilla/mux"
 )
 
-// RegisterRequest define a estrutura para registro de clientes
+// RegisterRequest define a estrutura para registro de profissionais. Só estes
+// campos vêm do cadastro; verificação, avaliação e status são do sistema.
 //
-//	@Description	Dados necessários para registrar um novo cliente
+//	@Description	Dados necessários para registrar um novo profissional
 type RegisterRequest struct {
-	Name     string `json:"name" example:"João Silva"`
-	Email    string `json:"email" example:"cliente@example.com"`
-	Password string `json:"password" example:"senhaSegura123"`
-	Phone    string `json:"phone" example:"+5511999999999"`
+	Name       string `json:"name" example:"João Silva"`
+	Email      string `json:"email" example:"profissional@example.com"`
+	Password   string `json:"password" example:"senhaSegura123"`
+	Phone      string `json:"phone" example:"+5511999999999"`
+	Profession string `json:"profession" example:"Eletricista"`
+	Bio        string `json:"bio" example:"Eletricista há 10 anos"`
+	Experience int    `json:"experience" example:"10"`
+	TimeZone   string `json:"time_zone" example:"America/Sao_Paulo"`
+	Locale     string `json:"locale" example:"pt-BR"`
+	// Endereço e área atendida
+	Address            geo.Address `json:"address"`
+	ServiceRadiusKm    float64     `json:"service_radius_km" example:"15"`
+	ServicePostalCodes []string    `json:"service_postal_codes" example:"01310,01311000"`
+}
+
+func (req *RegisterRequest) toProfessional() *domain.Professional {
+	return &domain.Professional{
+		Name:               req.Name,
+		Email:              req.Email,
+		Password:           req.Password,
+		Phone:              req.Phone,
+		Profession:         req.Profession,
+		Bio:                req.Bio,
+		Experience:         req.Experience,
+		TimeZone:           req.TimeZone,
+		Locale:             req.Locale,
+		Address:            req.Address,
+		ServiceRadiusKm:    req.ServiceRadiusKm,
+		ServicePostalCodes: req.ServicePostalCodes,
+	}
 }
 
 // LoginRequest define a estrutura para login de clientes
@@ -56,13 +87,18 @@ func NewProfessionalHandler(service service.ProfessionalService) *ProfessionalHa
 //	@Failure		500				{object}	map[string]string	"Erro interno"
 //	@Router			/professional/register [post]
 func (h *ProfessionalHandler) Register(w http.ResponseWriter, r *http.Request) {
-	var professional domain.Professional
-	if err := json.NewDecoder(r.Body).Decode(&professional); err != nil {
+	var req RegisterRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	if err := h.service.Register(&professional); err != nil {
+	professional := req.toProfessional()
+	if err := h.service.Register(r.Context(), professional); err != nil {
+		if errors.Is(err, timezone.ErrInvalid) || errors.Is(err, geo.ErrInvalidAddress) || errors.Is(err, geo.ErrInvalidServiceArea) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -91,7 +127,8 @@ func (h *ProfessionalHandler) GetProfessionalByID(w http.ResponseWriter, r *http
 	}
 
 	professional, err := h.service.GetProfessionalByID(uint(id))
-	if err != nil {
+	// Perfis desativados não aparecem publicamente
+	if err != nil || !professional.Active {
 		http.Error(w, "Professional not found", http.StatusNotFound)
 		return
 	}
@@ -150,3 +187,165 @@ func (h *ProfessionalHandler) Login(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]string{"token": token})
 }
+
+// SearchProfessionals godoc
+//
+//	@Summary		Buscar profissionais
+//	@Description	Filtra profissionais por profissão, nota mínima, verificação, experiência, faixa de preço dos serviços ativos, texto livre no nome/bio e distância a partir de um ponto. Os resultados vêm ordenados pelo score de relevância e paginados por cursor.
+//	@Tags			Professionals
+//	@Produce		json
+//	@Param			q				query		string	false	"Texto buscado no nome e na bio"
+//	@Param			profession		query		string	false	"Profissão (sem diferenciar maiúsculas)"
+//	@Param			min_rating		query		number	false	"Nota mínima (0 a 5)"
+//	@Param			verified		query		bool	false	"Somente verificados (true) ou não verificados (false)"
+//	@Param			min_experience	query		int		false	"Anos mínimos de experiência"
+//	@Param			min_price_cents	query		int		false	"Preço mínimo de algum serviço ativo, em centavos"
+//	@Param			max_price_cents	query		int		false	"Preço máximo de algum serviço ativo, em centavos"
+//	@Param			lat				query		number	false	"Latitude do ponto de busca (exige lng)"
+//	@Param			lng				query		number	false	"Longitude do ponto de busca (exige lat)"
+//	@Param			radius_km		query		number	false	"Raio em km a partir do ponto (padrão 50, máximo 500)"
+//	@Param			limit			query		int		false	"Tamanho da página (padrão 20, máximo 100)"
+//	@Param			cursor			query		string	false	"next_cursor da página anterior"
+//	@Success		200				{object}	service.SearchPage
+//	@Failure		400				{object}	map[string]string	"Filtros inválidos"
+//	@Failure		500				{object}	map[string]string	"Erro interno"
+//	@Router			/professionals/search [get]
+func (h *ProfessionalHandler) SearchProfessionals(w http.ResponseWriter, r *http.Request) {
+	params := searchParams{values: r.URL.Query()}
+	query := &domain.SearchQuery{
+		Text:          params.values.Get("q"),
+		Profession:    params.values.Get("profession"),
+		MinRating:     params.float("min_rating"),
+		Verified:      params.bool("verified"),
+		MinExperience: params.int("min_experience"),
+		MinPriceCents: params.int64("min_price_cents"),
+		MaxPriceCents: params.int64("max_price_cents"),
+		Latitude:      params.float("lat"),
+		Longitude:     params.float("lng"),
+	}
+	if radius := params.float("radius_km"); radius != nil {
+		query.RadiusKm = *radius
+	}
+	limit := 0
+	if l := params.int("limit"); l != nil {
+		limit = *l
+		if limit <= 0 {
+			params.err = domain.ErrInvalidSearchQuery
+		}
+	}
+	if params.err != nil {
+		http.Error(w, "Invalid search filters", http.StatusBadRequest)
+		return
+	}
+
+	page, err := h.service.SearchProfessionals(query, params.values.Get("cursor"), limit)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidSearchQuery) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "Error searching professionals", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(page)
+}
+
+// searchParams lê parâmetros opcionais da query string; o primeiro valor
+// inválido fica em err e os ausentes voltam como nil
+type searchParams struct {
+	values url.Values
+	err    error
+}
+
+func (p *searchParams) parse(key string, parse func(string) error) bool {
+	raw := p.values.Get(key)
+	if raw == "" {
+		return false
+	}
+	if err := parse(raw); err != nil {
+		if p.err == nil {
+			p.err = err
+		}
+		return false
+	}
+	return true
+}
+
+func (p *searchParams) float(key string) *float64 {
+	var v float64
+	if !p.parse(key, func(raw string) (err error) { v, err = strconv.ParseFloat(raw, 64); return }) {
+		return nil
+	}
+	return &v
+}
+
+func (p *searchParams) int(key string) *int {
+	var v int
+	if !p.parse(key, func(raw string) (err error) { v, err = strconv.Atoi(raw); return }) {
+		return nil
+	}
+	return &v
+}
+
+func (p *searchParams) int64(key string) *int64 {
+	var v int64
+	if !p.parse(key, func(raw string) (err error) { v, err = strconv.ParseInt(raw, 10, 64); return }) {
+		return nil
+	}
+	return &v
+}
+
+func (p *searchParams) bool(key string) *bool {
+	var v bool
+	if !p.parse(key, func(raw string) (err error) { v, err = strconv.ParseBool(raw); return }) {
+		return nil
+	}
+	return &v
+}
+
+// UpdateServiceArea godoc
+//
+//	@Summary		Atualizar área de atendimento
+//	@Description	Define o endereço do profissional autenticado e a região atendida: um raio em km a partir das coordenadas do endereço e/ou uma lista de CEPs (ou prefixos). Agendamentos com endereço fora dessa região são recusados.
+//	@Tags			Professionals
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header		string						true	"Token de autenticação (Bearer token)"
+//	@Param			area			body		service.ServiceAreaRequest	true	"Endereço e área de atendimento"
+//	@Success		200				{object}	domain.Professional
+//	@Failure		400				{object}	map[string]string	"Endereço ou área inválidos"
+//	@Failure		401				{object}	map[string]string	"Não autorizado"
+//	@Failure		404				{object}	map[string]string	"Profissional não encontrado"
+//	@Router			/professional/service-area [put]
+func (h *ProfessionalHandler) UpdateServiceArea(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := professionalIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req service.ServiceAreaRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	professional, err := h.service.UpdateServiceArea(professionalID, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, geo.ErrInvalidAddress), errors.Is(err, geo.ErrInvalidServiceArea):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case errors.Is(err, domain.ErrProfessionalNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, "Error updating service area", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(professional)
+}
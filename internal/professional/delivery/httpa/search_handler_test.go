@@ -0,0 +1,104 @@
+package httpa
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"1mao/internal/professional/domain"
+	"1mao/internal/professional/repository"
+	"1mao/internal/professional/service"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSearchParams(t *testing.T) {
+	params := searchParams{values: url.Values{
+		"min_rating":      {"4.5"},
+		"verified":        {"true"},
+		"min_experience":  {"3"},
+		"max_price_cents": {"15000"},
+	}}
+
+	assert.Equal(t, 4.5, *params.float("min_rating"))
+	assert.True(t, *params.bool("verified"))
+	assert.Equal(t, 3, *params.int("min_experience"))
+	assert.Equal(t, int64(15000), *params.int64("max_price_cents"))
+	assert.Nil(t, params.float("lat"), "ausente volta nil")
+	assert.NoError(t, params.err)
+
+	invalid := []struct {
+		key   string
+		value string
+		read  func(p *searchParams, key string) bool
+	}{
+		{"min_rating", "quatro", func(p *searchParams, key string) bool { return p.float(key) == nil }},
+		{"verified", "talvez", func(p *searchParams, key string) bool { return p.bool(key) == nil }},
+		{"min_experience", "2.5", func(p *searchParams, key string) bool { return p.int(key) == nil }},
+		{"max_price_cents", "99999999999999999999", func(p *searchParams, key string) bool { return p.int64(key) == nil }},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.key+"="+tt.value, func(t *testing.T) {
+			params := searchParams{values: url.Values{tt.key: {tt.value}}}
+			assert.True(t, tt.read(&params, tt.key))
+			assert.Error(t, params.err)
+		})
+	}
+
+	t.Run("first error wins", func(t *testing.T) {
+		params := searchParams{values: url.Values{"lat": {"x"}, "lng": {"y"}}}
+		params.float("lat")
+		first := params.err
+		params.float("lng")
+		assert.Same(t, first, params.err)
+	})
+}
+
+func TestSearchProfessionalsHandler(t *testing.T) {
+	newHandler := func() (*ProfessionalHandler, *repository.MockProfessionalRepository) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		cache := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1})
+		return NewProfessionalHandler(service.NewProfessionalService(mockRepo, cache, nil, nil, nil)), mockRepo
+	}
+
+	t.Run("Success - filters reach the repository", func(t *testing.T) {
+		handler, mockRepo := newHandler()
+		mockRepo.On("Search", mock.MatchedBy(func(q *domain.SearchQuery) bool {
+			return q.Profession == "eletricista" && *q.MinRating == 4 && *q.Verified &&
+				*q.Latitude == -23.5 && *q.Longitude == -46.6 && q.RadiusKm == 10
+		}), (*domain.SearchCursor)(nil), 6).Return(nil, nil).Once()
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/professionals/search?profession=eletricista&min_rating=4&verified=true&lat=-23.5&lng=-46.6&radius_km=10&limit=5", nil)
+
+		handler.SearchProfessionals(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"results":[]}`, w.Body.String())
+		mockRepo.AssertExpectations(t)
+	})
+
+	for _, query := range []string{
+		"min_rating=abc",
+		"verified=sim",
+		"limit=0",
+		"limit=-3",
+		"limit=1000",
+		"lat=-23.5",
+		"radius_km=10",
+		"min_price_cents=500&max_price_cents=100",
+		"cursor=adulterado",
+	} {
+		t.Run("Error - "+query, func(t *testing.T) {
+			handler, mockRepo := newHandler()
+			w := httptest.NewRecorder()
+
+			handler.SearchProfessionals(w, httptest.NewRequest(http.MethodGet, "/professionals/search?"+query, nil))
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			mockRepo.AssertNotCalled(t, "Search", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package httpa
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"1mao/internal/middleware"
+	"1mao/internal/professional/domain"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/gorilla/mux"
+)
+
+// ServiceOfferingRequest define a estrutura para criar ou alterar um serviço
+//
+//	@Description	Dados de um serviço do catálogo do profissional
+type ServiceOfferingRequest struct {
+	Name            string `json:"name" example:"Limpeza residencial"`
+	Description     string `json:"description" example:"Limpeza completa de até 3 cômodos"`
+	DurationMinutes int    `json:"duration_minutes" example:"120"`
+	PriceCents      int64  `json:"price_cents" example:"15000"`
+	Currency        string `json:"currency" example:"BRL"`
+	Active          *bool  `json:"active" example:"true"`
+}
+
+func (req *ServiceOfferingRequest) toDomain() *domain.ServiceOffering {
+	active := true
+	if req.Active != nil {
+		active = *req.Active
+	}
+	return &domain.ServiceOffering{
+		Name:            req.Name,
+		Description:     req.Description,
+		DurationMinutes: req.DurationMinutes,
+		PriceCents:      req.PriceCents,
+		Currency:        req.Currency,
+		Active:          active,
+	}
+}
+
+// ListServices godoc
+//
+//	@Summary		Listar serviços de um profissional
+//	@Description	Retorna os serviços ativos do catálogo de um profissional
+//	@Tags			Services
+//	@Produce		json
+//	@Param			id	path		int	true	"ID do profissional"
+//	@Success		200	{array}		domain.ServiceOffering
+//	@Failure		400	{object}	map[string]string	"ID inválido"
+//	@Router			/professional/{id}/services [get]
+func (h *ProfessionalHandler) ListServices(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid ID", http.StatusBadRequest)
+		return
+	}
+
+	offerings, err := h.service.ListServices(uint(id), true)
+	if err != nil {
+		http.Error(w, "Error fetching services", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(offerings)
+}
+
+// ListMyServices godoc
+//
+//	@Summary		Listar meus serviços
+//	@Description	Retorna todos os serviços (ativos e inativos) do profissional autenticado
+//	@Tags			Services
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header	string	true	"Token de autenticação (Bearer token)"
+//	@Produce		json
+//	@Success		200	{array}		domain.ServiceOffering
+//	@Failure		401	{object}	map[string]string	"Não autorizado"
+//	@Router			/professional/services [get]
+func (h *ProfessionalHandler) ListMyServices(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := professionalIDFromContext(r)
+	if !ok {
+		http.Error(w, "Invalid token", http.StatusUnauthorized)
+		return
+	}
+
+	offerings, err := h.service.ListServices(professionalID, false)
+	if err != nil {
+		http.Error(w, "Error fetching services", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(offerings)
+}
+
+// CreateService godoc
+//
+//	@Summary		Criar serviço
+//	@Description	Adiciona um serviço ao catálogo do profissional autenticado
+//	@Tags			Services
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header	string	true	"Token de autenticação (Bearer token)"
+//	@Accept			json
+//	@Produce		json
+//	@Param			service	body		ServiceOfferingRequest	true	"Dados do serviço"
+//	@Success		201		{object}	domain.ServiceOffering
+//	@Failure		400		{object}	map[string]string	"Dados inválidos"
+//	@Failure		401		{object}	map[string]string	"Não autorizado"
+//	@Router			/professional/services [post]
+func (h *ProfessionalHandler) CreateService(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := professionalIDFromContext(r)
+	if !ok {
+		http.Error(w, "Invalid token", http.StatusUnauthorized)
+		return
+	}
+
+	var req ServiceOfferingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	offering := req.toDomain()
+	if err := h.service.CreateService(professionalID, offering); err != nil {
+		writeServiceOfferingError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(offering)
+}
+
+// UpdateService godoc
+//
+//	@Summary		Alterar serviço
+//	@Description	Altera um serviço do catálogo do profissional autenticado
+//	@Tags			Services
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header	string	true	"Token de autenticação (Bearer token)"
+//	@Accept			json
+//	@Produce		json
+//	@Param			service_id	path		int						true	"ID do serviço"
+//	@Param			service		body		ServiceOfferingRequest	true	"Dados do serviço"
+//	@Success		200			{object}	domain.ServiceOffering
+//	@Failure		400			{object}	map[string]string	"Dados inválidos"
+//	@Failure		404			{object}	map[string]string	"Serviço não encontrado"
+//	@Router			/professional/services/{service_id} [put]
+func (h *ProfessionalHandler) UpdateService(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := professionalIDFromContext(r)
+	if !ok {
+		http.Error(w, "Invalid token", http.StatusUnauthorized)
+		return
+	}
+
+	id, err := strconv.Atoi(mux.Vars(r)["service_id"])
+	if err != nil {
+		http.Error(w, "Invalid ID", http.StatusBadRequest)
+		return
+	}
+
+	var req ServiceOfferingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	offering, err := h.service.UpdateService(professionalID, uint(id), req.toDomain())
+	if err != nil {
+		writeServiceOfferingError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(offering)
+}
+
+// DeleteService godoc
+//
+//	@Summary		Remover serviço
+//	@Description	Remove um serviço do catálogo do profissional autenticado
+//	@Tags			Services
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header	string	true	"Token de autenticação (Bearer token)"
+//	@Param			service_id		path	int		true	"ID do serviço"
+//	@Success		204
+//	@Failure		404	{object}	map[string]string	"Serviço não encontrado"
+//	@Router			/professional/services/{service_id} [delete]
+func (h *ProfessionalHandler) DeleteService(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := professionalIDFromContext(r)
+	if !ok {
+		http.Error(w, "Invalid token", http.StatusUnauthorized)
+		return
+	}
+
+	id, err := strconv.Atoi(mux.Vars(r)["service_id"])
+	if err != nil {
+		http.Error(w, "Invalid ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.DeleteService(professionalID, uint(id)); err != nil {
+		writeServiceOfferingError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeServiceOfferingError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrServiceOfferingNotFound):
+		http.Error(w, "Service not found", http.StatusNotFound)
+	case errors.Is(err, domain.ErrInvalidServiceOffering):
+		http.Error(w, "Invalid service data", http.StatusBadRequest)
+	default:
+		http.Error(w, "Error saving service", http.StatusInternalServerError)
+	}
+}
+
+// professionalIDFromContext extrai o ID do profissional autenticado das claims do JWT
+func professionalIDFromContext(r *http.Request) (uint, bool) {
+	claims, ok := r.Context().Value(middleware.UserContextKey).(jwt.MapClaims)
+	if !ok {
+		return 0, false
+	}
+	id, ok := claims["user_id"].(float64)
+	if !ok {
+		return 0, false
+	}
+	return uint(id), true
+}
@@ -0,0 +1,340 @@
+package httpa
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	"1mao/internal/professional/domain"
+	"1mao/pkg/storage"
+
+	"github.com/gorilla/mux"
+)
+
+// VerificationReviewRequest define a decisão do administrador
+//
+//	@Description	Motivo da aprovação (opcional) ou da recusa (obrigatório)
+type VerificationReviewRequest struct {
+	Reason string `json:"reason" example:"Documento ilegível"`
+}
+
+// UploadVerificationDocument godoc
+//
+//	@Summary		Enviar documento de verificação
+//	@Description	Envia um documento (PDF, JPEG ou PNG de até 10 MB) do profissional autenticado. O documento fica guardado até a solicitação de verificação ser enviada.
+//	@Tags			Verification
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header		string	true	"Token de autenticação (Bearer token)"
+//	@Param			kind			formData	string	true	"Tipo do documento (id_document ou certification)"
+//	@Param			file			formData	file	true	"Arquivo do documento"
+//	@Success		201				{object}	domain.VerificationDocument
+//	@Failure		400				{object}	map[string]string	"Documento inválido"
+//	@Failure		401				{object}	map[string]string	"Não autorizado"
+//	@Router			/professional/verification/documents [post]
+func (h *ProfessionalHandler) UploadVerificationDocument(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := professionalIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Folga para os demais campos do formulário
+	r.Body = http.MaxBytesReader(w, r.Body, domain.MaxDocumentSize+1<<20)
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "Invalid document", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	document, err := h.service.UploadVerificationDocument(
+		professionalID,
+		domain.DocumentKind(r.FormValue("kind")),
+		header.Filename,
+		header.Size,
+		file,
+	)
+	if err != nil {
+		writeVerificationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(document)
+}
+
+// DeleteVerificationDocument godoc
+//
+//	@Summary		Remover documento de verificação
+//	@Description	Remove um documento do profissional autenticado que ainda não foi enviado para análise
+//	@Tags			Verification
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header	string	true	"Token de autenticação (Bearer token)"
+//	@Param			document_id		path	int		true	"ID do documento"
+//	@Success		204
+//	@Failure		404	{object}	map[string]string	"Documento não encontrado"
+//	@Router			/professional/verification/documents/{document_id} [delete]
+func (h *ProfessionalHandler) DeleteVerificationDocument(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := professionalIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["document_id"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid document ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.DeleteVerificationDocument(professionalID, uint(id)); err != nil {
+		writeVerificationError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SubmitVerification godoc
+//
+//	@Summary		Solicitar verificação
+//	@Description	Envia os documentos guardados para análise. É preciso ao menos um documento de identidade; só existe uma solicitação pendente por vez.
+//	@Tags			Verification
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header		string	true	"Token de autenticação (Bearer token)"
+//	@Success		201				{object}	domain.VerificationRequest
+//	@Failure		409				{object}	map[string]string	"Já verificado ou com solicitação pendente"
+//	@Failure		422				{object}	map[string]string	"Falta documento de identidade"
+//	@Router			/professional/verification/submit [post]
+func (h *ProfessionalHandler) SubmitVerification(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := professionalIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	request, err := h.service.SubmitVerification(professionalID)
+	if err != nil {
+		writeVerificationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(request)
+}
+
+// GetVerificationStatus godoc
+//
+//	@Summary		Situação da verificação
+//	@Description	Retorna se o profissional autenticado está verificado, a solicitação mais recente e os documentos ainda não enviados
+//	@Tags			Verification
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header		string	true	"Token de autenticação (Bearer token)"
+//	@Success		200				{object}	service.VerificationStatusResponse
+//	@Failure		401				{object}	map[string]string	"Não autorizado"
+//	@Router			/professional/verification [get]
+func (h *ProfessionalHandler) GetVerificationStatus(w http.ResponseWriter, r *http.Request) {
+	professionalID, ok := professionalIDFromContext(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	status, err := h.service.GetVerificationStatus(professionalID)
+	if err != nil {
+		writeVerificationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(status)
+}
+
+// ListVerifications godoc
+//
+//	@Summary		Listar solicitações de verificação
+//	@Description	Lista as solicitações no status pedido (padrão pending), mais antigas primeiro
+//	@Tags			Admin
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header		string	true	"Token de autenticação (Bearer token)"
+//	@Param			status			query		string	false	"pending, approved ou rejected"
+//	@Success		200				{array}		domain.VerificationRequest
+//	@Failure		400				{object}	map[string]string	"Status inválido"
+//	@Router			/admin/verifications [get]
+func (h *ProfessionalHandler) ListVerifications(w http.ResponseWriter, r *http.Request) {
+	status := domain.VerificationStatus(r.URL.Query().Get("status"))
+	switch status {
+	case "", domain.VerificationPending, domain.VerificationApproved, domain.VerificationRejected:
+	default:
+		http.Error(w, "Invalid status", http.StatusBadRequest)
+		return
+	}
+
+	requests, err := h.service.ListVerifications(status)
+	if err != nil {
+		writeVerificationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(requests)
+}
+
+// GetVerification godoc
+//
+//	@Summary		Obter solicitação de verificação
+//	@Description	Retorna a solicitação com os documentos enviados
+//	@Tags			Admin
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header		string	true	"Token de autenticação (Bearer token)"
+//	@Param			id				path		int		true	"ID da solicitação"
+//	@Success		200				{object}	domain.VerificationRequest
+//	@Failure		404				{object}	map[string]string	"Solicitação não encontrada"
+//	@Router			/admin/verifications/{id} [get]
+func (h *ProfessionalHandler) GetVerification(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid ID", http.StatusBadRequest)
+		return
+	}
+
+	request, err := h.service.GetVerification(uint(id))
+	if err != nil {
+		writeVerificationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(request)
+}
+
+// DownloadVerificationDocument godoc
+//
+//	@Summary		Baixar documento de verificação
+//	@Description	Retorna o arquivo enviado pelo profissional
+//	@Tags			Admin
+//	@Produce		application/octet-stream
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header	string	true	"Token de autenticação (Bearer token)"
+//	@Param			document_id		path	int		true	"ID do documento"
+//	@Success		200				{file}	file
+//	@Failure		404				{object}	map[string]string	"Documento não encontrado"
+//	@Router			/admin/verifications/documents/{document_id}/file [get]
+func (h *ProfessionalHandler) DownloadVerificationDocument(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(mux.Vars(r)["document_id"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid document ID", http.StatusBadRequest)
+		return
+	}
+
+	document, file, err := h.service.OpenVerificationDocument(uint(id))
+	if err != nil {
+		writeVerificationError(w, err)
+		return
+	}
+	defer file.Close()
+
+	w.Header().Set("Content-Type", document.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", document.FileName))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if _, err := io.Copy(w, file); err != nil {
+		log.Printf("erro ao enviar documento %d: %v", document.ID, err)
+	}
+}
+
+// ApproveVerification godoc
+//
+//	@Summary		Aprovar verificação
+//	@Description	Aprova a solicitação pendente, marca o profissional como verificado e o notifica
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header		string						true	"Token de autenticação (Bearer token)"
+//	@Param			id				path		int							true	"ID da solicitação"
+//	@Param			review			body		VerificationReviewRequest	false	"Motivo (opcional)"
+//	@Success		200				{object}	domain.VerificationRequest
+//	@Failure		404				{object}	map[string]string	"Solicitação não encontrada"
+//	@Failure		409				{object}	map[string]string	"Solicitação já analisada"
+//	@Router			/admin/verifications/{id}/approve [post]
+func (h *ProfessionalHandler) ApproveVerification(w http.ResponseWriter, r *http.Request) {
+	h.reviewVerification(w, r, true)
+}
+
+// RejectVerification godoc
+//
+//	@Summary		Recusar verificação
+//	@Description	Recusa a solicitação pendente com um motivo e notifica o profissional, que pode enviar novos documentos
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			Authorization	header		string						true	"Token de autenticação (Bearer token)"
+//	@Param			id				path		int							true	"ID da solicitação"
+//	@Param			review			body		VerificationReviewRequest	true	"Motivo da recusa"
+//	@Success		200				{object}	domain.VerificationRequest
+//	@Failure		400				{object}	map[string]string	"Motivo ausente"
+//	@Failure		404				{object}	map[string]string	"Solicitação não encontrada"
+//	@Failure		409				{object}	map[string]string	"Solicitação já analisada"
+//	@Router			/admin/verifications/{id}/reject [post]
+func (h *ProfessionalHandler) RejectVerification(w http.ResponseWriter, r *http.Request) {
+	h.reviewVerification(w, r, false)
+}
+
+func (h *ProfessionalHandler) reviewVerification(w http.ResponseWriter, r *http.Request, approve bool) {
+	adminID, ok := professionalIDFromContext(r) // as claims do admin têm o mesmo formato
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 32)
+	if err != nil {
+		http.Error(w, "Invalid ID", http.StatusBadRequest)
+		return
+	}
+
+	var req VerificationReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	request, err := h.service.ReviewVerification(adminID, uint(id), approve, req.Reason)
+	if err != nil {
+		writeVerificationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(request)
+}
+
+// writeVerificationError traduz os erros da verificação em status HTTP
+func writeVerificationError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrInvalidDocument), errors.Is(err, domain.ErrInvalidVerificationCheck):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrDocumentNotFound), errors.Is(err, domain.ErrVerificationNotFound),
+		errors.Is(err, domain.ErrProfessionalNotFound), errors.Is(err, storage.ErrNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, domain.ErrVerificationPending), errors.Is(err, domain.ErrAlreadyVerified),
+		errors.Is(err, domain.ErrVerificationNotPending):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, domain.ErrIncompleteVerification):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	default:
+		http.Error(w, "Error processing verification", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,106 @@
+package httpa
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"1mao/internal/middleware"
+	"1mao/internal/professional/domain"
+	"1mao/internal/professional/repository"
+	"1mao/internal/professional/service"
+	"1mao/pkg/storage"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// uploadRequest monta o formulário multipart de envio de documento já com o
+// profissional autenticado no contexto
+func uploadRequest(t *testing.T, kind string, content []byte) *http.Request {
+	t.Helper()
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	require.NoError(t, form.WriteField("kind", kind))
+	part, err := form.CreateFormFile("file", "documento.pdf")
+	require.NoError(t, err)
+	_, err = part.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, form.Close())
+
+	r := httptest.NewRequest(http.MethodPost, "/professional/verification/documents", &body)
+	r.Header.Set("Content-Type", form.FormDataContentType())
+	claims := jwt.MapClaims{"user_id": float64(1), "role": "professional"}
+	return r.WithContext(context.WithValue(r.Context(), middleware.UserContextKey, claims))
+}
+
+func TestUploadVerificationDocument(t *testing.T) {
+	newHandler := func(t *testing.T) (*ProfessionalHandler, *repository.MockProfessionalRepository) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		cache := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1})
+		professionalService := service.NewProfessionalService(mockRepo, cache, storage.NewLocalStore(t.TempDir()), nil, nil)
+		return NewProfessionalHandler(professionalService), mockRepo
+	}
+
+	t.Run("Success - pdf is stored", func(t *testing.T) {
+		handler, mockRepo := newHandler(t)
+		mockRepo.On("CreateDocument", mock.AnythingOfType("*domain.VerificationDocument")).Return(nil).Once()
+		w := httptest.NewRecorder()
+
+		handler.UploadVerificationDocument(w, uploadRequest(t, string(domain.DocumentID), []byte("%PDF-1.4\n%%EOF\n")))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"content_type":"application/pdf"`)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - content that is not a document", func(t *testing.T) {
+		handler, mockRepo := newHandler(t)
+		w := httptest.NewRecorder()
+
+		// O nome diz .pdf, mas o conteúdo é HTML
+		handler.UploadVerificationDocument(w, uploadRequest(t, string(domain.DocumentID), []byte("<html><script>alert(1)</script></html>")))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockRepo.AssertNotCalled(t, "CreateDocument", mock.Anything)
+	})
+
+	t.Run("Error - body over the limit", func(t *testing.T) {
+		handler, mockRepo := newHandler(t)
+		w := httptest.NewRecorder()
+		content := append([]byte("%PDF-1.4\n"), make([]byte, domain.MaxDocumentSize+1<<20)...)
+
+		handler.UploadVerificationDocument(w, uploadRequest(t, string(domain.DocumentID), content))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockRepo.AssertNotCalled(t, "CreateDocument", mock.Anything)
+	})
+
+	t.Run("Error - file just over the document limit", func(t *testing.T) {
+		handler, mockRepo := newHandler(t)
+		w := httptest.NewRecorder()
+		// Cabe na folga do MaxBytesReader, mas passa de MaxDocumentSize
+		content := append([]byte("%PDF-1.4\n"), make([]byte, domain.MaxDocumentSize)...)
+
+		handler.UploadVerificationDocument(w, uploadRequest(t, string(domain.DocumentID), content))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockRepo.AssertNotCalled(t, "CreateDocument", mock.Anything)
+	})
+
+	t.Run("Error - missing authentication", func(t *testing.T) {
+		handler, _ := newHandler(t)
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "/professional/verification/documents", nil)
+
+		handler.UploadVerificationDocument(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
@@ -1,7 +1,15 @@
 // internal/professional/domain/professional.go
 package domain
 
-import "time"
+import (
+	"1mao/pkg/geo"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var ErrProfessionalNotFound = errors.New("professional not found")
 
 // Professional representa um profissional
 //	@Description	Modelo completo de profissional
@@ -14,8 +22,62 @@ type Professional struct {
 	Name       string    `json:"name" gorm:"not null"`
 	Email      string    `json:"email" gorm:"unique;not null"`
 	Password   string    `json:"-" gorm:"not null"`
+	// Hash SHA-256 do token de redefinição de senha e sua validade
+	ResetToken       string    `json:"-" gorm:"index" swaggerignore:"true"`
+	ResetTokenExpiry time.Time `json:"-" swaggerignore:"true"`
+	// Momento em que o profissional confirmou o e-mail
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	// Último envio do link de verificação, para limitar os reenvios
+	VerificationSentAt time.Time `json:"-" swaggerignore:"true"`
+	Phone      string    `json:"phone" example:"+5511999999999"`
+	// Endereço público da foto de perfil; vazio quando não há foto
+	PhotoURL string `json:"photo_url,omitempty" example:"/professional/1/photo"`
+	// Chave da foto no BlobStore
+	PhotoKey string `json:"-"`
+	// Profissional desativado some da listagem e da busca e não recebe agendamentos
+	Active    bool           `json:"active" gorm:"not null;default:true"`
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index" swaggerignore:"true"`
 	Profession string    `json:"profession" gorm:"not null"`
+	// Apresentação livre do profissional, usada também na busca por texto
+	Bio        string    `json:"bio" gorm:"type:text"`
 	Experience int       `json:"experience" gorm:"default:0"`
 	Rating     float32   `json:"rating" gorm:"default:0"`
+	// Quantidade de avaliações que compõem a média em Rating
+	ReviewCount int `json:"review_count" gorm:"not null;default:0"`
 	Verified   bool      `json:"verified" gorm:"default:false"`
+	// Fuso IANA em que a agenda do profissional é avaliada
+	TimeZone string `json:"time_zone" gorm:"type:varchar(64);not null;default:America/Sao_Paulo" example:"America/Sao_Paulo"`
+	// Idioma dos e-mails enviados ao profissional (pt-BR ou en)
+	Locale string `json:"locale" gorm:"type:varchar(10);not null;default:pt-BR" example:"pt-BR"`
+	// Endereço do profissional; as coordenadas dele são o ponto usado na busca
+	// por distância e o centro do raio de atendimento
+	Address geo.Address `json:"address" gorm:"embedded;embeddedPrefix:address_"`
+	// Raio atendido a partir do endereço, em km; zero não limita por distância
+	ServiceRadiusKm float64 `json:"service_radius_km" gorm:"not null;default:0" example:"15"`
+	// CEPs (ou prefixos de CEP) atendidos
+	ServicePostalCodes []string `json:"service_postal_codes" gorm:"serializer:json;type:text" example:"01310,01311000"`
+}
+
+// ServiceArea devolve a região atendida pelo profissional
+func (p *Professional) ServiceArea() geo.ServiceArea {
+	return geo.ServiceArea{
+		Latitude:    p.Address.Latitude,
+		Longitude:   p.Address.Longitude,
+		RadiusKm:    p.ServiceRadiusKm,
+		PostalCodes: p.ServicePostalCodes,
+	}
+}
+
+// ValidateLocation normaliza e valida o endereço (opcional) e a área de atendimento
+func (p *Professional) ValidateLocation() error {
+	if !p.Address.IsZero() {
+		if err := p.Address.Normalize(); err != nil {
+			return err
+		}
+	}
+	for i, code := range p.ServicePostalCodes {
+		p.ServicePostalCodes[i] = geo.NormalizePostalCode(code)
+	}
+	area := p.ServiceArea()
+	return area.Validate()
 }
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"1mao/pkg/auth"
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// Limites do perfil do profissional
+const (
+	MaxBioLength  = 2000
+	MaxExperience = 80
+	MaxPhotoSize  = 5 << 20
+)
+
+var (
+	ErrInvalidProfile = errors.New("invalid profile")
+	ErrInvalidPhoto   = errors.New("invalid photo")
+	ErrActiveBookings = errors.New("professional has active bookings")
+	// Erros da verificação de e-mail (a verificação por documentos tem os seus)
+	ErrEmailAlreadyVerified = errors.New("email already verified")
+)
+
+// AllowedPhotoTypes são os formatos aceitos para a foto de perfil
+var AllowedPhotoTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+}
+
+// ValidateProfile normaliza e valida os campos editáveis do perfil
+func (p *Professional) ValidateProfile() error {
+	p.Profession = strings.TrimSpace(p.Profession)
+	p.Bio = strings.TrimSpace(p.Bio)
+	p.Phone = strings.TrimSpace(p.Phone)
+
+	if p.Profession == "" || p.Experience < 0 || p.Experience > MaxExperience {
+		return ErrInvalidProfile
+	}
+	if utf8.RuneCountInString(p.Bio) > MaxBioLength {
+		return ErrInvalidProfile
+	}
+	if p.Phone != "" && !auth.ValidPhone(p.Phone) {
+		return ErrInvalidProfile
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"1mao/pkg/geo"
+)
+
+// Limites da busca de profissionais
+const (
+	DefaultSearchPageSize = 20
+	MaxSearchPageSize     = 100
+	DefaultSearchRadiusKm = 50
+	MaxSearchRadiusKm     = 500
+)
+
+// Pesos que compõem o score de relevância da busca (máximo 1.0)
+const (
+	WeightNameMatch  = 0.25
+	WeightBioMatch   = 0.10
+	WeightRating     = 0.35
+	WeightVerified   = 0.10
+	WeightExperience = 0.05
+	WeightDistance   = 0.15
+	// Anos de experiência a partir dos quais o peso fica completo
+	ExperienceCapYears = 20
+)
+
+var ErrInvalidSearchQuery = errors.New("invalid search query")
+
+// SearchQuery reúne os filtros da busca de profissionais. Campos nulos ou
+// vazios não filtram.
+type SearchQuery struct {
+	Text          string
+	Profession    string
+	MinRating     *float64
+	Verified      *bool
+	MinExperience *int
+	MinPriceCents *int64
+	MaxPriceCents *int64
+	// Latitude e Longitude vêm juntas; RadiusKm só vale com elas
+	Latitude  *float64
+	Longitude *float64
+	RadiusKm  float64
+}
+
+// HasLocation indica se a busca é feita a partir de um ponto
+func (q *SearchQuery) HasLocation() bool {
+	return q.Latitude != nil && q.Longitude != nil
+}
+
+// Normalize completa os padrões e valida os filtros
+func (q *SearchQuery) Normalize() error {
+	q.Text = strings.TrimSpace(q.Text)
+	q.Profession = strings.TrimSpace(q.Profession)
+
+	if q.MinRating != nil && (*q.MinRating < 0 || *q.MinRating > 5) {
+		return ErrInvalidSearchQuery
+	}
+	if q.MinExperience != nil && *q.MinExperience < 0 {
+		return ErrInvalidSearchQuery
+	}
+	if q.MinPriceCents != nil && *q.MinPriceCents < 0 {
+		return ErrInvalidSearchQuery
+	}
+	if q.MaxPriceCents != nil && *q.MaxPriceCents < 0 {
+		return ErrInvalidSearchQuery
+	}
+	if q.MinPriceCents != nil && q.MaxPriceCents != nil && *q.MinPriceCents > *q.MaxPriceCents {
+		return ErrInvalidSearchQuery
+	}
+
+	if (q.Latitude == nil) != (q.Longitude == nil) {
+		return ErrInvalidSearchQuery
+	}
+	if !q.HasLocation() {
+		if q.RadiusKm != 0 {
+			return ErrInvalidSearchQuery
+		}
+		return nil
+	}
+	if !geo.ValidCoordinates(*q.Latitude, *q.Longitude) {
+		return ErrInvalidSearchQuery
+	}
+	if q.RadiusKm == 0 {
+		q.RadiusKm = DefaultSearchRadiusKm
+	}
+	if q.RadiusKm < 0 || q.RadiusKm > MaxSearchRadiusKm {
+		return ErrInvalidSearchQuery
+	}
+	return nil
+}
+
+// SearchResult é um profissional encontrado pela busca
+//
+//	@Description	Profissional encontrado na busca, com relevância e distância
+//	@name			SearchResult
+//	@model			SearchResult
+type SearchResult struct {
+	Professional
+	// Score de relevância entre 0 e 1; os resultados vêm do maior para o menor
+	Score float64 `json:"score" example:"0.82"`
+	// Distância em km até o ponto da busca; ausente quando a busca não tem ponto
+	DistanceKm *float64 `json:"distance_km,omitempty" example:"3.4"`
+}
+
+// SearchCursor marca o último resultado entregue. O ID desempata
+// profissionais com o mesmo score.
+type SearchCursor struct {
+	Score float64 `json:"k"`
+	ID    uint    `json:"i"`
+}
+
+// CursorAfterResult cria o cursor que continua a busca depois de r
+func CursorAfterResult(r *SearchResult) *SearchCursor {
+	return &SearchCursor{Score: r.Score, ID: r.ID}
+}
+
+// Encode serializa o cursor num token opaco para a URL
+func (c *SearchCursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeSearchCursor lê o token gerado por Encode
+func DecodeSearchCursor(token string) (*SearchCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidSearchQuery
+	}
+	var c SearchCursor
+	if err := json.Unmarshal(raw, &c); err != nil || c.ID == 0 {
+		return nil, ErrInvalidSearchQuery
+	}
+	return &c, nil
+}
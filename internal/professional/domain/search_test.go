@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchQuery_Normalize(t *testing.T) {
+	float := func(v float64) *float64 { return &v }
+	integer := func(v int) *int { return &v }
+	cents := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name   string
+		query  SearchQuery
+		err    error
+		radius float64
+	}{
+		{name: "empty query", query: SearchQuery{}},
+		{name: "rating bounds", query: SearchQuery{MinRating: float(5)}},
+		{name: "rating below zero", query: SearchQuery{MinRating: float(-0.1)}, err: ErrInvalidSearchQuery},
+		{name: "rating above five", query: SearchQuery{MinRating: float(5.1)}, err: ErrInvalidSearchQuery},
+		{name: "negative experience", query: SearchQuery{MinExperience: integer(-1)}, err: ErrInvalidSearchQuery},
+		{name: "negative min price", query: SearchQuery{MinPriceCents: cents(-1)}, err: ErrInvalidSearchQuery},
+		{name: "negative max price", query: SearchQuery{MaxPriceCents: cents(-1)}, err: ErrInvalidSearchQuery},
+		{name: "equal price range", query: SearchQuery{MinPriceCents: cents(5000), MaxPriceCents: cents(5000)}},
+		{name: "inverted price range", query: SearchQuery{MinPriceCents: cents(5000), MaxPriceCents: cents(4999)}, err: ErrInvalidSearchQuery},
+		{name: "latitude without longitude", query: SearchQuery{Latitude: float(-23.5)}, err: ErrInvalidSearchQuery},
+		{name: "radius without point", query: SearchQuery{RadiusKm: 10}, err: ErrInvalidSearchQuery},
+		{name: "default radius", query: SearchQuery{Latitude: float(-23.5), Longitude: float(-46.6)}, radius: DefaultSearchRadiusKm},
+		{name: "max radius", query: SearchQuery{Latitude: float(-23.5), Longitude: float(-46.6), RadiusKm: MaxSearchRadiusKm}, radius: MaxSearchRadiusKm},
+		{name: "radius over max", query: SearchQuery{Latitude: float(-23.5), Longitude: float(-46.6), RadiusKm: MaxSearchRadiusKm + 1}, err: ErrInvalidSearchQuery},
+		{name: "negative radius", query: SearchQuery{Latitude: float(-23.5), Longitude: float(-46.6), RadiusKm: -1}, err: ErrInvalidSearchQuery},
+		{name: "invalid coordinates", query: SearchQuery{Latitude: float(91), Longitude: float(0)}, err: ErrInvalidSearchQuery},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := tt.query
+			err := query.Normalize()
+			assert.Equal(t, tt.err, err)
+			if err == nil {
+				assert.Equal(t, tt.radius, query.RadiusKm)
+			}
+		})
+	}
+
+	t.Run("trims text filters", func(t *testing.T) {
+		query := SearchQuery{Text: "  pintor ", Profession: " Eletricista  "}
+		require.NoError(t, query.Normalize())
+		assert.Equal(t, "pintor", query.Text)
+		assert.Equal(t, "Eletricista", query.Profession)
+	})
+}
+
+func TestSearchCursor(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		cursor := CursorAfterResult(&SearchResult{Professional: Professional{ID: 42}, Score: 0.8125})
+
+		decoded, err := DecodeSearchCursor(cursor.Encode())
+
+		require.NoError(t, err)
+		assert.Equal(t, cursor, decoded)
+	})
+
+	tampered := []struct {
+		name  string
+		token string
+	}{
+		{"empty", ""},
+		{"not base64", "***"},
+		{"not json", base64.RawURLEncoding.EncodeToString([]byte("score=1"))},
+		{"missing id", base64.RawURLEncoding.EncodeToString([]byte(`{"k":0.5}`))},
+		{"wrong types", base64.RawURLEncoding.EncodeToString([]byte(`{"k":"alto","i":1}`))},
+		{"negative id", base64.RawURLEncoding.EncodeToString([]byte(`{"k":0.5,"i":-1}`))},
+	}
+	for _, tt := range tampered {
+		t.Run("rejects "+tt.name, func(t *testing.T) {
+			_, err := DecodeSearchCursor(tt.token)
+			assert.Equal(t, ErrInvalidSearchQuery, err)
+		})
+	}
+}
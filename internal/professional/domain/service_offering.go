@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const DefaultCurrency = "BRL"
+
+var (
+	ErrServiceOfferingNotFound = errors.New("service offering not found")
+	ErrInvalidServiceOffering  = errors.New("invalid service offering")
+)
+
+// ServiceOffering representa um serviço oferecido por um profissional
+//
+//	@Description	Serviço do catálogo de um profissional, com duração e preço
+//	@name			ServiceOffering
+//	@model			ServiceOffering
+type ServiceOffering struct {
+	ID              uint           `json:"id" gorm:"primaryKey"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	DeletedAt       gorm.DeletedAt `json:"-" gorm:"index" swaggerignore:"true"`
+	ProfessionalID  uint           `json:"professional_id" gorm:"index;not null"`
+	Name            string         `json:"name" gorm:"not null"`
+	Description     string         `json:"description"`
+	DurationMinutes int            `json:"duration_minutes" gorm:"not null"`
+	PriceCents      int64          `json:"price_cents" gorm:"not null"` // Em centavos (R$100.00 = 10000)
+	Currency        string         `json:"currency" gorm:"type:varchar(3);not null"`
+	Active          bool           `json:"active" gorm:"not null"`
+}
+
+// Duration retorna a duração do serviço
+func (s *ServiceOffering) Duration() time.Duration {
+	return time.Duration(s.DurationMinutes) * time.Minute
+}
+
+// Validate verifica os campos obrigatórios do serviço
+func (s *ServiceOffering) Validate() error {
+	if s.Name == "" || s.DurationMinutes <= 0 || s.PriceCents < 0 || len(s.Currency) != 3 {
+		return ErrInvalidServiceOffering
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxDocumentSize limita o tamanho de cada documento enviado (10 MB)
+const MaxDocumentSize = 10 << 20
+
+// MaxVerificationReasonLength limita o motivo registrado na análise
+const MaxVerificationReasonLength = 1000
+
+var (
+	ErrVerificationNotFound     = errors.New("verification request not found")
+	ErrDocumentNotFound         = errors.New("verification document not found")
+	ErrInvalidDocument          = errors.New("invalid verification document")
+	ErrIncompleteVerification   = errors.New("an identity document is required")
+	ErrVerificationPending      = errors.New("verification already pending review")
+	ErrAlreadyVerified          = errors.New("professional already verified")
+	ErrVerificationNotPending   = errors.New("verification request is not pending")
+	ErrInvalidVerificationCheck = errors.New("invalid verification review")
+)
+
+type DocumentKind string
+
+const (
+	// Documento de identidade (RG, CNH, passaporte)
+	DocumentID DocumentKind = "id_document"
+	// Certificado ou registro profissional
+	DocumentCertification DocumentKind = "certification"
+)
+
+// Valid indica se o tipo de documento é aceito
+func (k DocumentKind) Valid() bool {
+	return k == DocumentID || k == DocumentCertification
+}
+
+// AllowedDocumentTypes são os formatos aceitos, detectados pelo conteúdo
+var AllowedDocumentTypes = map[string]string{
+	"application/pdf": ".pdf",
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+}
+
+type VerificationStatus string
+
+const (
+	VerificationPending  VerificationStatus = "pending"
+	VerificationApproved VerificationStatus = "approved"
+	VerificationRejected VerificationStatus = "rejected"
+)
+
+// VerificationDocument é um arquivo enviado pelo profissional. Fica sem
+// RequestID até o profissional enviar a solicitação de verificação.
+//
+//	@Description	Documento enviado para verificação do profissional
+//	@name			VerificationDocument
+//	@model			VerificationDocument
+type VerificationDocument struct {
+	ID             uint         `json:"id" gorm:"primaryKey"`
+	ProfessionalID uint         `json:"professional_id" gorm:"index;not null"`
+	RequestID      *uint        `json:"request_id,omitempty" gorm:"index"`
+	Kind           DocumentKind `json:"kind" gorm:"type:varchar(32);not null" example:"id_document"`
+	FileName       string       `json:"file_name" gorm:"not null" example:"rg.pdf"`
+	ContentType    string       `json:"content_type" gorm:"type:varchar(64);not null" example:"application/pdf"`
+	Size           int64        `json:"size" gorm:"not null"`
+	// Chave do arquivo no BlobStore
+	StorageKey string    `json:"-" gorm:"not null"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// VerificationRequest é uma solicitação de verificação com os documentos
+// enviados e o resultado da análise do administrador. Cada reenvio após uma
+// recusa gera uma nova solicitação, preservando o histórico.
+//
+//	@Description	Solicitação de verificação do profissional
+//	@name			VerificationRequest
+//	@model			VerificationRequest
+type VerificationRequest struct {
+	ID             uint               `json:"id" gorm:"primaryKey"`
+	ProfessionalID uint               `json:"professional_id" gorm:"index;not null"`
+	Status         VerificationStatus `json:"status" gorm:"type:varchar(16);not null;index" example:"pending"`
+	// Motivo informado pelo administrador na aprovação ou recusa
+	Reason     string                 `json:"reason,omitempty" gorm:"type:text"`
+	ReviewedBy *uint                  `json:"reviewed_by,omitempty"`
+	ReviewedAt *time.Time             `json:"reviewed_at,omitempty"`
+	Documents  []VerificationDocument `json:"documents" gorm:"foreignKey:RequestID"`
+	CreatedAt  time.Time              `json:"created_at"`
+	UpdatedAt  time.Time              `json:"updated_at"`
+}
+
+// Review aplica a decisão do administrador a uma solicitação pendente.
+// Recusas exigem motivo.
+func (v *VerificationRequest) Review(adminID uint, approve bool, reason string, now time.Time) error {
+	if v.Status != VerificationPending {
+		return ErrVerificationNotPending
+	}
+	reason = strings.TrimSpace(reason)
+	if (!approve && reason == "") || utf8.RuneCountInString(reason) > MaxVerificationReasonLength {
+		return ErrInvalidVerificationCheck
+	}
+
+	v.Status = VerificationRejected
+	if approve {
+		v.Status = VerificationApproved
+	}
+	v.Reason = reason
+	v.ReviewedBy = &adminID
+	v.ReviewedAt = &now
+	v.UpdatedAt = now
+	return nil
+}
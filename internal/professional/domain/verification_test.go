@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerificationRequest_Review(t *testing.T) {
+	now := time.Date(2030, 1, 2, 15, 0, 0, 0, time.UTC)
+
+	t.Run("Success - approve without reason", func(t *testing.T) {
+		request := &VerificationRequest{ID: 1, Status: VerificationPending}
+
+		err := request.Review(9, true, "", now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, VerificationApproved, request.Status)
+		assert.Equal(t, uint(9), *request.ReviewedBy)
+		assert.Equal(t, now, *request.ReviewedAt)
+	})
+
+	t.Run("Success - reject with trimmed reason", func(t *testing.T) {
+		request := &VerificationRequest{ID: 1, Status: VerificationPending}
+
+		err := request.Review(9, false, "  Documento ilegível ", now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, VerificationRejected, request.Status)
+		assert.Equal(t, "Documento ilegível", request.Reason)
+	})
+
+	t.Run("Error - reject without reason", func(t *testing.T) {
+		request := &VerificationRequest{ID: 1, Status: VerificationPending}
+
+		err := request.Review(9, false, "   ", now)
+
+		assert.Equal(t, ErrInvalidVerificationCheck, err)
+		assert.Equal(t, VerificationPending, request.Status)
+		assert.Nil(t, request.ReviewedBy)
+	})
+
+	t.Run("Error - reason too long", func(t *testing.T) {
+		request := &VerificationRequest{ID: 1, Status: VerificationPending}
+
+		err := request.Review(9, true, strings.Repeat("a", MaxVerificationReasonLength+1), now)
+
+		assert.Equal(t, ErrInvalidVerificationCheck, err)
+	})
+
+	t.Run("Error - second review is refused", func(t *testing.T) {
+		for _, approve := range []bool{true, false} {
+			request := &VerificationRequest{ID: 1, Status: VerificationPending}
+			assert.NoError(t, request.Review(9, approve, "motivo", now))
+			decided := request.Status
+
+			err := request.Review(10, !approve, "motivo", now.Add(time.Hour))
+
+			assert.Equal(t, ErrVerificationNotPending, err)
+			assert.Equal(t, decided, request.Status)
+			assert.Equal(t, uint(9), *request.ReviewedBy)
+		}
+	})
+}
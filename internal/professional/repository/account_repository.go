@@ -0,0 +1,113 @@
+package repository
+
+import (
+	booking "1mao/internal/booking/domain"
+	"1mao/internal/professional/domain"
+	"1mao/pkg/auth"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (r *professionalRepository) SetActive(id uint, active bool) error {
+	return r.db.Model(&domain.Professional{}).Where("id = ?", id).Update("active", active).Error
+}
+
+// ResetPassword grava a nova senha se o token ainda valer, consumindo-o na
+// mesma atualização para que dois pedidos com o mesmo token não passem
+func (r *professionalRepository) ResetPassword(tokenHash, password string, now time.Time) error {
+	result := r.db.Model(&domain.Professional{}).
+		Where("reset_token = ? AND reset_token_expiry > ?", tokenHash, now).
+		Updates(map[string]interface{}{
+			"password":           password,
+			"reset_token":        "",
+			"reset_token_expiry": now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return auth.ErrInvalidToken
+	}
+	return nil
+}
+
+// DeleteAccount apaga os dados pessoais do profissional e o remove (soft
+// delete), junto com o catálogo e os documentos de verificação. Recusa se
+// houver agendamentos ativos ainda por acontecer. Devolve as chaves dos
+// arquivos no BlobStore, que o chamador remove depois do commit.
+func (r *professionalRepository) DeleteAccount(id uint, now time.Time) ([]string, error) {
+	var keys []string
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var professional domain.Professional
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&professional, id).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrProfessionalNotFound
+			}
+			return err
+		}
+
+		var active int64
+		if err := tx.Model(&booking.Booking{}).
+			Where("professional_id = ? AND status IN ? AND end_time > ?", id, booking.ActiveStatuses, now).
+			Count(&active).Error; err != nil {
+			return err
+		}
+		if active > 0 {
+			return domain.ErrActiveBookings
+		}
+
+		var documents []domain.VerificationDocument
+		if err := tx.Where("professional_id = ?", id).Find(&documents).Error; err != nil {
+			return err
+		}
+		for _, d := range documents {
+			keys = append(keys, d.StorageKey)
+		}
+		if professional.PhotoKey != "" {
+			keys = append(keys, professional.PhotoKey)
+		}
+		if err := tx.Where("professional_id = ?", id).Delete(&domain.VerificationDocument{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("professional_id = ?", id).Delete(&domain.ServiceOffering{}).Error; err != nil {
+			return err
+		}
+
+		// O e-mail anonimizado libera o endereço original para um novo cadastro
+		anonymized := map[string]interface{}{
+			"name":                 "Profissional removido",
+			"email":                fmt.Sprintf("deleted-%d@removed.invalid", id),
+			"phone":                "",
+			"bio":                  "",
+			"photo_url":            "",
+			"photo_key":            "",
+			"reset_token":          "",
+			"active":               false,
+			"service_radius_km":    0,
+			"service_postal_codes": nil,
+		}
+		for _, column := range addressColumns {
+			anonymized[column] = nil
+		}
+		if err := tx.Model(&professional).Updates(anonymized).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&professional).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// addressColumns são as colunas do endereço embutido do profissional
+var addressColumns = []string{
+	"address_street", "address_number", "address_complement", "address_district",
+	"address_city", "address_state", "address_postal_code", "address_latitude", "address_longitude",
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// Migrate ajusta os dados que o AutoMigrate não cobre. Deve ser chamado
+// depois do AutoMigrate de domain.Professional.
+//
+// Contas anteriores à verificação de e-mail ganharam email_verified_at nulo;
+// elas contam como verificadas desde o cadastro. Só essas contas têm
+// verification_sent_at nulo (o cadastro e o reenvio sempre o preenchem), o
+// que torna o ajuste seguro de repetir a cada subida.
+func Migrate(db *gorm.DB) error {
+	err := db.Exec(`UPDATE professionals SET email_verified_at = created_at
+		WHERE email_verified_at IS NULL AND verification_sent_at IS NULL`).Error
+	if err != nil {
+		return fmt.Errorf("erro ao marcar e-mails de profissionais antigos como verificados: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"1mao/internal/professional/domain"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockProfessionalRepository struct {
+	mock.Mock
+}
+
+func (m *MockProfessionalRepository) Create(professional *domain.Professional) error {
+	args := m.Called(professional)
+	return args.Error(0)
+}
+
+func (m *MockProfessionalRepository) FindByID(id uint) (*domain.Professional, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Professional), args.Error(1)
+}
+
+func (m *MockProfessionalRepository) FindByEmail(email string) (*domain.Professional, error) {
+	args := m.Called(email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Professional), args.Error(1)
+}
+
+func (m *MockProfessionalRepository) GetAllProfessionals() ([]domain.Professional, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Professional), args.Error(1)
+}
+
+func (m *MockProfessionalRepository) Update(professional *domain.Professional, columns ...string) error {
+	args := m.Called(professional, columns)
+	return args.Error(0)
+}
+
+func (m *MockProfessionalRepository) SetActive(id uint, active bool) error {
+	args := m.Called(id, active)
+	return args.Error(0)
+}
+
+func (m *MockProfessionalRepository) ResetPassword(tokenHash, password string, now time.Time) error {
+	args := m.Called(tokenHash, password, now)
+	return args.Error(0)
+}
+
+func (m *MockProfessionalRepository) DeleteAccount(id uint, now time.Time) ([]string, error) {
+	args := m.Called(id, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockProfessionalRepository) Search(query *domain.SearchQuery, after *domain.SearchCursor, limit int) ([]domain.SearchResult, error) {
+	args := m.Called(query, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.SearchResult), args.Error(1)
+}
+
+func (m *MockProfessionalRepository) CreateService(offering *domain.ServiceOffering) error {
+	args := m.Called(offering)
+	return args.Error(0)
+}
+
+func (m *MockProfessionalRepository) FindServiceByID(id uint) (*domain.ServiceOffering, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ServiceOffering), args.Error(1)
+}
+
+func (m *MockProfessionalRepository) ListServices(professionalID uint, onlyActive bool) ([]domain.ServiceOffering, error) {
+	args := m.Called(professionalID, onlyActive)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.ServiceOffering), args.Error(1)
+}
+
+func (m *MockProfessionalRepository) UpdateService(offering *domain.ServiceOffering) error {
+	args := m.Called(offering)
+	return args.Error(0)
+}
+
+func (m *MockProfessionalRepository) DeleteService(id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockProfessionalRepository) CreateDocument(document *domain.VerificationDocument) error {
+	args := m.Called(document)
+	return args.Error(0)
+}
+
+func (m *MockProfessionalRepository) FindDocument(id uint) (*domain.VerificationDocument, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.VerificationDocument), args.Error(1)
+}
+
+func (m *MockProfessionalRepository) ListUnsubmittedDocuments(professionalID uint) ([]domain.VerificationDocument, error) {
+	args := m.Called(professionalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.VerificationDocument), args.Error(1)
+}
+
+func (m *MockProfessionalRepository) DeleteDocument(id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockProfessionalRepository) SubmitVerification(professionalID uint) (*domain.VerificationRequest, error) {
+	args := m.Called(professionalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.VerificationRequest), args.Error(1)
+}
+
+func (m *MockProfessionalRepository) FindVerification(id uint) (*domain.VerificationRequest, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.VerificationRequest), args.Error(1)
+}
+
+func (m *MockProfessionalRepository) LatestVerification(professionalID uint) (*domain.VerificationRequest, error) {
+	args := m.Called(professionalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.VerificationRequest), args.Error(1)
+}
+
+func (m *MockProfessionalRepository) ListVerifications(status domain.VerificationStatus) ([]domain.VerificationRequest, error) {
+	args := m.Called(status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.VerificationRequest), args.Error(1)
+}
+
+func (m *MockProfessionalRepository) ReviewVerification(id, adminID uint, approve bool, reason string, now time.Time) (*domain.VerificationRequest, error) {
+	args := m.Called(id, adminID, approve, reason, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.VerificationRequest), args.Error(1)
+}
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"1mao/internal/professional/domain"
+	"errors"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -11,7 +13,27 @@ type ProfessionalRepository interface {
 	FindByID(id uint)(*domain.Professional, error)
 	FindByEmail(email string) (*domain.Professional, error)
 	GetAllProfessionals()([]domain.Professional, error)
+	Update(professional *domain.Professional, columns ...string) error
+	SetActive(id uint, active bool) error
+	ResetPassword(tokenHash, password string, now time.Time) error
+	DeleteAccount(id uint, now time.Time) ([]string, error)
+	Search(query *domain.SearchQuery, after *domain.SearchCursor, limit int) ([]domain.SearchResult, error)
 
+	CreateService(offering *domain.ServiceOffering) error
+	FindServiceByID(id uint) (*domain.ServiceOffering, error)
+	ListServices(professionalID uint, onlyActive bool) ([]domain.ServiceOffering, error)
+	UpdateService(offering *domain.ServiceOffering) error
+	DeleteService(id uint) error
+
+	CreateDocument(document *domain.VerificationDocument) error
+	FindDocument(id uint) (*domain.VerificationDocument, error)
+	ListUnsubmittedDocuments(professionalID uint) ([]domain.VerificationDocument, error)
+	DeleteDocument(id uint) error
+	SubmitVerification(professionalID uint) (*domain.VerificationRequest, error)
+	FindVerification(id uint) (*domain.VerificationRequest, error)
+	LatestVerification(professionalID uint) (*domain.VerificationRequest, error)
+	ListVerifications(status domain.VerificationStatus) ([]domain.VerificationRequest, error)
+	ReviewVerification(id, adminID uint, approve bool, reason string, now time.Time) (*domain.VerificationRequest, error)
 }
 
 type professionalRepository struct {
@@ -46,8 +68,54 @@ func (r *professionalRepository) FindByEmail(email string)(*domain.Professional,
 
 func (r *professionalRepository) GetAllProfessionals()([]domain.Professional, error){
 	var professionals []domain.Professional
-	if err := r.db.Find(&professionals).Error; err !=nil{
+	if err := r.db.Where("active = ?", true).Find(&professionals).Error; err !=nil{
 		return nil, err
 	}
 	return professionals, nil
 }
+
+
+// Update grava só as colunas informadas (nomes do banco). A linha inteira
+// nunca é regravada: rating e review_count são incrementados pela transação
+// das avaliações e um Save com o registro lido antes os sobrescreveria.
+func (r *professionalRepository) Update(professional *domain.Professional, columns ...string) error {
+	if len(columns) == 0 {
+		return errors.New("nenhuma coluna informada para atualizar o profissional")
+	}
+	return r.db.Model(professional).Select(columns).Updates(professional).Error
+}
+
+func (r *professionalRepository) CreateService(offering *domain.ServiceOffering) error {
+	return r.db.Create(offering).Error
+}
+
+func (r *professionalRepository) FindServiceByID(id uint) (*domain.ServiceOffering, error) {
+	var offering domain.ServiceOffering
+	if err := r.db.First(&offering, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrServiceOfferingNotFound
+		}
+		return nil, err
+	}
+	return &offering, nil
+}
+
+func (r *professionalRepository) ListServices(professionalID uint, onlyActive bool) ([]domain.ServiceOffering, error) {
+	var offerings []domain.ServiceOffering
+	query := r.db.Where("professional_id = ?", professionalID)
+	if onlyActive {
+		query = query.Where("active = ?", true)
+	}
+	if err := query.Order("name ASC").Find(&offerings).Error; err != nil {
+		return nil, err
+	}
+	return offerings, nil
+}
+
+func (r *professionalRepository) UpdateService(offering *domain.ServiceOffering) error {
+	return r.db.Save(offering).Error
+}
+
+func (r *professionalRepository) DeleteService(id uint) error {
+	return r.db.Delete(&domain.ServiceOffering{}, id).Error
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"1mao/internal/professional/domain"
+	"1mao/pkg/geo"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// Search filtra os profissionais e os ordena pelo score de relevância
+// (maior primeiro, ID desempata). A consulta interna calcula distância e score;
+// a externa aplica o raio e o cursor sobre as colunas já calculadas.
+func (r *professionalRepository) Search(query *domain.SearchQuery, after *domain.SearchCursor, limit int) ([]domain.SearchResult, error) {
+	distance := "NULL::float8"
+	if query.HasLocation() {
+		distance = haversineSQL(*query.Latitude, *query.Longitude)
+	}
+
+	score := fmt.Sprintf(
+		"LEAST(p.rating::float8, 5) / 5 * %g"+
+			" + CASE WHEN p.verified THEN %g ELSE 0 END"+
+			" + LEAST(GREATEST(p.experience, 0), %d)::float8 / %d * %g",
+		domain.WeightRating, domain.WeightVerified,
+		domain.ExperienceCapYears, domain.ExperienceCapYears, domain.WeightExperience,
+	)
+	var scoreArgs []interface{}
+	if query.Text != "" {
+		pattern := "%" + likeEscaper.Replace(query.Text) + "%"
+		score += fmt.Sprintf(
+			" + CASE WHEN p.name ILIKE ? THEN %g ELSE 0 END + CASE WHEN p.bio ILIKE ? THEN %g ELSE 0 END",
+			domain.WeightNameMatch, domain.WeightBioMatch,
+		)
+		scoreArgs = append(scoreArgs, pattern, pattern)
+	}
+	if query.HasLocation() {
+		score += fmt.Sprintf(" + GREATEST(0, 1 - (%s) / %g) * %g", distance, query.RadiusKm, domain.WeightDistance)
+	}
+
+	inner := r.db.Table("professionals AS p").
+		Select(fmt.Sprintf("p.*, %s AS distance_km, %s AS score", distance, score), scoreArgs...).
+		Where("p.active AND p.deleted_at IS NULL")
+
+	if query.Text != "" {
+		pattern := "%" + likeEscaper.Replace(query.Text) + "%"
+		inner = inner.Where("(p.name ILIKE ? OR p.bio ILIKE ?)", pattern, pattern)
+	}
+	if query.Profession != "" {
+		inner = inner.Where("LOWER(p.profession) = LOWER(?)", query.Profession)
+	}
+	if query.MinRating != nil {
+		inner = inner.Where("p.rating >= ?", *query.MinRating)
+	}
+	if query.Verified != nil {
+		inner = inner.Where("p.verified = ?", *query.Verified)
+	}
+	if query.MinExperience != nil {
+		inner = inner.Where("p.experience >= ?", *query.MinExperience)
+	}
+	if query.MinPriceCents != nil || query.MaxPriceCents != nil {
+		offers := r.db.Table("service_offerings AS so").
+			Select("1").
+			Where("so.professional_id = p.id AND so.active AND so.deleted_at IS NULL")
+		if query.MinPriceCents != nil {
+			offers = offers.Where("so.price_cents >= ?", *query.MinPriceCents)
+		}
+		if query.MaxPriceCents != nil {
+			offers = offers.Where("so.price_cents <= ?", *query.MaxPriceCents)
+		}
+		inner = inner.Where("EXISTS (?)", offers)
+	}
+	if query.HasLocation() {
+		inner = inner.Where("p.address_latitude IS NOT NULL AND p.address_longitude IS NOT NULL")
+	}
+
+	outer := r.db.Table("(?) AS s", inner)
+	if query.HasLocation() {
+		outer = outer.Where("s.distance_km <= ?", query.RadiusKm)
+	}
+	if after != nil {
+		outer = outer.Where("(s.score < ? OR (s.score = ? AND s.id > ?))", after.Score, after.Score, after.ID)
+	}
+
+	var results []domain.SearchResult
+	if err := outer.Order("s.score DESC, s.id ASC").Limit(limit).Scan(&results).Error; err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// haversineSQL monta a distância em km entre o profissional e o ponto. As
+// coordenadas já foram validadas, então entram formatadas na própria expressão,
+// que é repetida no score.
+func haversineSQL(lat, lng float64) string {
+	latStr := strconv.FormatFloat(lat, 'f', -1, 64)
+	lngStr := strconv.FormatFloat(lng, 'f', -1, 64)
+	return fmt.Sprintf(
+		"2 * %g * ASIN(SQRT(LEAST(1, "+
+			"POWER(SIN(RADIANS(p.address_latitude - (%s)) / 2), 2) + "+
+			"COS(RADIANS(%s)) * COS(RADIANS(p.address_latitude)) * POWER(SIN(RADIANS(p.address_longitude - (%s)) / 2), 2))))",
+		geo.EarthRadiusKm, latStr, latStr, lngStr,
+	)
+}
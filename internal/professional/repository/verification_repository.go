@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"1mao/internal/professional/domain"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (r *professionalRepository) CreateDocument(document *domain.VerificationDocument) error {
+	return r.db.Create(document).Error
+}
+
+func (r *professionalRepository) FindDocument(id uint) (*domain.VerificationDocument, error) {
+	var document domain.VerificationDocument
+	if err := r.db.First(&document, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrDocumentNotFound
+		}
+		return nil, err
+	}
+	return &document, nil
+}
+
+// ListUnsubmittedDocuments devolve os documentos ainda não anexados a uma solicitação
+func (r *professionalRepository) ListUnsubmittedDocuments(professionalID uint) ([]domain.VerificationDocument, error) {
+	var documents []domain.VerificationDocument
+	err := r.db.Where("professional_id = ? AND request_id IS NULL", professionalID).
+		Order("created_at ASC").
+		Find(&documents).Error
+	return documents, err
+}
+
+// DeleteDocument remove um documento que ainda não foi enviado para análise
+func (r *professionalRepository) DeleteDocument(id uint) error {
+	result := r.db.Where("request_id IS NULL").Delete(&domain.VerificationDocument{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrDocumentNotFound
+	}
+	return nil
+}
+
+// SubmitVerification cria a solicitação com os documentos ainda não enviados.
+// A linha do profissional fica travada para que dois envios simultâneos não
+// abram duas solicitações pendentes.
+func (r *professionalRepository) SubmitVerification(professionalID uint) (*domain.VerificationRequest, error) {
+	var request *domain.VerificationRequest
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var professional domain.Professional
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "verified").
+			First(&professional, professionalID).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrProfessionalNotFound
+			}
+			return err
+		}
+		if professional.Verified {
+			return domain.ErrAlreadyVerified
+		}
+
+		var pending int64
+		if err := tx.Model(&domain.VerificationRequest{}).
+			Where("professional_id = ? AND status = ?", professionalID, domain.VerificationPending).
+			Count(&pending).Error; err != nil {
+			return err
+		}
+		if pending > 0 {
+			return domain.ErrVerificationPending
+		}
+
+		var documents []domain.VerificationDocument
+		if err := tx.Where("professional_id = ? AND request_id IS NULL", professionalID).
+			Order("created_at ASC").
+			Find(&documents).Error; err != nil {
+			return err
+		}
+		hasID := false
+		for _, d := range documents {
+			hasID = hasID || d.Kind == domain.DocumentID
+		}
+		if !hasID {
+			return domain.ErrIncompleteVerification
+		}
+
+		request = &domain.VerificationRequest{
+			ProfessionalID: professionalID,
+			Status:         domain.VerificationPending,
+		}
+		if err := tx.Omit("Documents").Create(request).Error; err != nil {
+			return err
+		}
+
+		ids := make([]uint, 0, len(documents))
+		for i := range documents {
+			ids = append(ids, documents[i].ID)
+			documents[i].RequestID = &request.ID
+		}
+		if err := tx.Model(&domain.VerificationDocument{}).
+			Where("id IN ?", ids).
+			Update("request_id", request.ID).Error; err != nil {
+			return err
+		}
+		request.Documents = documents
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func (r *professionalRepository) FindVerification(id uint) (*domain.VerificationRequest, error) {
+	var request domain.VerificationRequest
+	if err := r.db.Preload("Documents").First(&request, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrVerificationNotFound
+		}
+		return nil, err
+	}
+	return &request, nil
+}
+
+// LatestVerification devolve a solicitação mais recente do profissional
+func (r *professionalRepository) LatestVerification(professionalID uint) (*domain.VerificationRequest, error) {
+	var request domain.VerificationRequest
+	err := r.db.Preload("Documents").
+		Where("professional_id = ?", professionalID).
+		Order("created_at DESC, id DESC").
+		First(&request).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrVerificationNotFound
+		}
+		return nil, err
+	}
+	return &request, nil
+}
+
+// ListVerifications lista as solicitações no status, mais antigas primeiro
+func (r *professionalRepository) ListVerifications(status domain.VerificationStatus) ([]domain.VerificationRequest, error) {
+	var requests []domain.VerificationRequest
+	err := r.db.Preload("Documents").
+		Where("status = ?", status).
+		Order("created_at ASC, id ASC").
+		Find(&requests).Error
+	return requests, err
+}
+
+// ReviewVerification registra a decisão do administrador e, na aprovação,
+// marca o profissional como verificado na mesma transação
+func (r *professionalRepository) ReviewVerification(id, adminID uint, approve bool, reason string, now time.Time) (*domain.VerificationRequest, error) {
+	var request domain.VerificationRequest
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&request, id).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrVerificationNotFound
+			}
+			return err
+		}
+
+		if err := request.Review(adminID, approve, reason, now); err != nil {
+			return err
+		}
+		if err := tx.Omit("Documents").Save(&request).Error; err != nil {
+			return err
+		}
+
+		if approve {
+			if err := tx.Model(&domain.Professional{}).
+				Where("id = ?", request.ProfessionalID).
+				Update("verified", true).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Where("request_id = ?", request.ID).Order("created_at ASC").Find(&request.Documents).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
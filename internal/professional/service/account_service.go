@@ -0,0 +1,278 @@
+package service
+
+import (
+	"1mao/internal/professional/domain"
+	"1mao/pkg/auth"
+	"1mao/pkg/email"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// ProfileUpdateRequest define os campos editáveis do perfil; campos ausentes
+// ficam como estão
+//
+//	@Description	Atualização parcial do perfil do profissional
+//	@name			ProfileUpdateRequest
+//	@model			ProfileUpdateRequest
+type ProfileUpdateRequest struct {
+	Bio        *string `json:"bio,omitempty" example:"Eletricista há 10 anos, atendo residências e comércios"`
+	Profession *string `json:"profession,omitempty" example:"Eletricista"`
+	Experience *int    `json:"experience,omitempty" example:"10"`
+	Phone      *string `json:"phone,omitempty" example:"+5511999999999"`
+	Locale     *string `json:"locale,omitempty" example:"pt-BR"`
+}
+
+// ChangePasswordRequest define a troca de senha
+//
+//	@Description	Senha atual e nova senha (mínimo de 8 caracteres)
+//	@name			ChangePasswordRequest
+//	@model			ChangePasswordRequest
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" example:"senhaAntiga123"`
+	NewPassword     string `json:"new_password" example:"senhaNova456"`
+}
+
+// findProfessional lê o profissional direto do banco, sem passar pelo cache
+func (s *professionalService) findProfessional(id uint) (*domain.Professional, error) {
+	professional, err := s.repo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrProfessionalNotFound
+		}
+		return nil, err
+	}
+	return professional, nil
+}
+
+// invalidateProfessional descarta o perfil e a listagem em cache
+func (s *professionalService) invalidateProfessional(id uint) {
+	NewProfileCache(s.cache).InvalidateProfessional(id)
+}
+
+// 🔹 Atualização parcial do perfil
+func (s *professionalService) UpdateProfile(professionalID uint, req *ProfileUpdateRequest) (*domain.Professional, error) {
+	professional, err := s.findProfessional(professionalID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Bio != nil {
+		professional.Bio = *req.Bio
+	}
+	if req.Profession != nil {
+		professional.Profession = *req.Profession
+	}
+	if req.Experience != nil {
+		professional.Experience = *req.Experience
+	}
+	if req.Phone != nil {
+		professional.Phone = *req.Phone
+	}
+	if req.Locale != nil {
+		locale, ok := email.MatchLocale(*req.Locale)
+		if !ok {
+			return nil, domain.ErrInvalidProfile
+		}
+		professional.Locale = locale
+	}
+	if err := professional.ValidateProfile(); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(professional, "bio", "profession", "experience", "phone", "locale"); err != nil {
+		return nil, err
+	}
+	s.invalidateProfessional(professionalID)
+	return professional, nil
+}
+
+// 🔹 Troca a foto de perfil; a anterior é removida depois de gravar a nova
+func (s *professionalService) UpdatePhoto(professionalID uint, size int64, content io.Reader) (*domain.Professional, error) {
+	if size <= 0 || size > domain.MaxPhotoSize {
+		return nil, domain.ErrInvalidPhoto
+	}
+	professional, err := s.findProfessional(professionalID)
+	if err != nil {
+		return nil, err
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(content, head)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return nil, domain.ErrInvalidPhoto
+	}
+	head = head[:n]
+	ext, ok := domain.AllowedPhotoTypes[http.DetectContentType(head)]
+	if !ok {
+		return nil, domain.ErrInvalidPhoto
+	}
+
+	key := fmt.Sprintf("photos/%d/%s%s", professionalID, uuid.NewString(), ext)
+	body := io.LimitReader(io.MultiReader(bytes.NewReader(head), content), size)
+	if err := s.blobs.Put(ctx, key, body); err != nil {
+		return nil, err
+	}
+
+	previous := professional.PhotoKey
+	professional.PhotoKey = key
+	professional.PhotoURL = fmt.Sprintf("/professional/%d/photo", professionalID)
+	if err := s.repo.Update(professional, "photo_key", "photo_url"); err != nil {
+		s.removeBlobs(key)
+		return nil, err
+	}
+	s.invalidateProfessional(professionalID)
+	if previous != "" {
+		s.removeBlobs(previous)
+	}
+	return professional, nil
+}
+
+// OpenPhoto abre a foto de perfil de um profissional ativo; quem chama fecha o leitor
+func (s *professionalService) OpenPhoto(professionalID uint) (string, io.ReadCloser, error) {
+	professional, err := s.findProfessional(professionalID)
+	if err != nil {
+		return "", nil, err
+	}
+	if !professional.Active || professional.PhotoKey == "" {
+		return "", nil, domain.ErrProfessionalNotFound
+	}
+	file, err := s.blobs.Open(ctx, professional.PhotoKey)
+	if err != nil {
+		return "", nil, err
+	}
+	return mime.TypeByExtension(path.Ext(professional.PhotoKey)), file, nil
+}
+
+// 🔹 Troca de senha, confirmando a senha atual
+func (s *professionalService) ChangePassword(professionalID uint, req *ChangePasswordRequest) error {
+	professional, err := s.findProfessional(professionalID)
+	if err != nil {
+		return err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(professional.Password), []byte(req.CurrentPassword)); err != nil {
+		return auth.ErrWrongPassword
+	}
+	if err := auth.ValidatePassword(req.NewPassword); err != nil {
+		return err
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	professional.Password = string(hashedPassword)
+	return s.repo.Update(professional, "password")
+}
+
+// 🔹 Desativa o perfil: some da listagem e da busca e deixa de receber
+// agendamentos. O profissional continua podendo entrar e reativar.
+func (s *professionalService) Deactivate(professionalID uint) error {
+	return s.setActive(professionalID, false)
+}
+
+func (s *professionalService) Reactivate(professionalID uint) error {
+	return s.setActive(professionalID, true)
+}
+
+func (s *professionalService) setActive(professionalID uint, active bool) error {
+	if _, err := s.findProfessional(professionalID); err != nil {
+		return err
+	}
+	if err := s.repo.SetActive(professionalID, active); err != nil {
+		return err
+	}
+	s.invalidateProfessional(professionalID)
+	return nil
+}
+
+// 🔹 Exclui a conta, confirmando a senha. Os dados pessoais são apagados e
+// os arquivos removidos do armazenamento.
+func (s *professionalService) DeleteAccount(professionalID uint, password string) error {
+	professional, err := s.findProfessional(professionalID)
+	if err != nil {
+		return err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(professional.Password), []byte(password)); err != nil {
+		return auth.ErrWrongPassword
+	}
+
+	keys, err := s.repo.DeleteAccount(professionalID, time.Now())
+	if err != nil {
+		return err
+	}
+	s.invalidateProfessional(professionalID)
+	s.removeBlobs(keys...)
+	return nil
+}
+
+// removeBlobs apaga arquivos que deixaram de ser referenciados; falhas só são registradas
+func (s *professionalService) removeBlobs(keys ...string) {
+	for _, key := range keys {
+		if err := s.blobs.Delete(ctx, key); err != nil {
+			log.Printf("erro ao remover arquivo %s: %v", key, err)
+		}
+	}
+}
+
+// 🔹 Envia o token de redefinição de senha. E-mails não cadastrados não
+// geram erro, para não revelar quem tem conta; pelo mesmo motivo o token e o
+// e-mail saem em segundo plano, sem atrasar a resposta, e falhas só são
+// registradas.
+func (s *professionalService) ForgotPassword(address string) error {
+	professional, err := s.repo.FindByEmail(strings.TrimSpace(address))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	email.SendAsync(ctx, fmt.Sprintf("redefinição de senha do profissional %d", professional.ID), func(ctx context.Context) error {
+		return s.sendPasswordReset(ctx, professional)
+	})
+	return nil
+}
+
+// sendPasswordReset gera o token, salva só o hash e o envia ao profissional
+func (s *professionalService) sendPasswordReset(ctx context.Context, professional *domain.Professional) error {
+	token, err := auth.NewOneTimeToken()
+	if err != nil {
+		return err
+	}
+	professional.ResetToken = auth.HashToken(token)
+	professional.ResetTokenExpiry = time.Now().Add(auth.ResetTokenTTL)
+	if err := s.repo.Update(professional, "reset_token", "reset_token_expiry"); err != nil {
+		return err
+	}
+	return email.SendTemplate(ctx, s.mailer, professional.Email, professional.Locale, email.TemplatePasswordReset,
+		email.PasswordResetData{Token: token, Professional: true})
+}
+
+// 🔹 Troca a senha com o token recebido por e-mail; o token vale uma única vez
+func (s *professionalService) ResetPassword(token, newPassword string) error {
+	if token == "" {
+		return auth.ErrInvalidToken
+	}
+	if err := auth.ValidatePassword(newPassword); err != nil {
+		return err
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	return s.repo.ResetPassword(auth.HashToken(token), string(hashedPassword), time.Now())
+}
@@ -0,0 +1,264 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"1mao/internal/professional/domain"
+	"1mao/internal/professional/repository"
+	"1mao/pkg/auth"
+	"1mao/pkg/email"
+	"1mao/pkg/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+func hashPassword(password string) string {
+	hashed, _ := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	return string(hashed)
+}
+
+var jpegContent = []byte("\xff\xd8\xff\xe0\x00\x10JFIF\x00")
+
+func TestChangePassword(t *testing.T) {
+	t.Run("Success - new password is hashed", func(t *testing.T) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		professionalService := NewProfessionalService(mockRepo, testCache(), nil, nil, nil)
+		mockRepo.On("FindByID", uint(1)).Return(&domain.Professional{ID: 1, Password: hashPassword("senhaAntiga123")}, nil).Once()
+		mockRepo.On("Update", mock.MatchedBy(func(p *domain.Professional) bool {
+			return bcrypt.CompareHashAndPassword([]byte(p.Password), []byte("senhaNova456")) == nil
+		}), []string{"password"}).Return(nil).Once()
+
+		err := professionalService.ChangePassword(1, &ChangePasswordRequest{CurrentPassword: "senhaAntiga123", NewPassword: "senhaNova456"})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - wrong current password", func(t *testing.T) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		professionalService := NewProfessionalService(mockRepo, testCache(), nil, nil, nil)
+		mockRepo.On("FindByID", uint(1)).Return(&domain.Professional{ID: 1, Password: hashPassword("senhaAntiga123")}, nil).Once()
+
+		err := professionalService.ChangePassword(1, &ChangePasswordRequest{CurrentPassword: "errada", NewPassword: "senhaNova456"})
+
+		assert.Equal(t, auth.ErrWrongPassword, err)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Error - new password too short", func(t *testing.T) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		professionalService := NewProfessionalService(mockRepo, testCache(), nil, nil, nil)
+		mockRepo.On("FindByID", uint(1)).Return(&domain.Professional{ID: 1, Password: hashPassword("senhaAntiga123")}, nil).Once()
+
+		err := professionalService.ChangePassword(1, &ChangePasswordRequest{CurrentPassword: "senhaAntiga123", NewPassword: "curta"})
+
+		assert.Equal(t, auth.ErrInvalidPassword, err)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Error - professional not found", func(t *testing.T) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		professionalService := NewProfessionalService(mockRepo, testCache(), nil, nil, nil)
+		mockRepo.On("FindByID", uint(1)).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		err := professionalService.ChangePassword(1, &ChangePasswordRequest{CurrentPassword: "senhaAntiga123", NewPassword: "senhaNova456"})
+
+		assert.Equal(t, domain.ErrProfessionalNotFound, err)
+	})
+}
+
+func TestUpdateProfile(t *testing.T) {
+	t.Run("Success - writes only the profile columns", func(t *testing.T) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		professionalService := NewProfessionalService(mockRepo, testCache(), nil, nil, nil)
+		mockRepo.On("FindByID", uint(1)).Return(&domain.Professional{ID: 1, Name: "Ana", Profession: "Eletricista", Rating: 4.5, ReviewCount: 10}, nil).Once()
+		// rating e review_count ficam de fora: são das avaliações
+		mockRepo.On("Update", mock.AnythingOfType("*domain.Professional"), []string{"bio", "profession", "experience", "phone", "locale"}).Return(nil).Once()
+
+		bio := "Eletricista há 10 anos"
+		professional, err := professionalService.UpdateProfile(1, &ProfileUpdateRequest{Bio: &bio})
+
+		require.NoError(t, err)
+		assert.Equal(t, bio, professional.Bio)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestUpdatePhoto(t *testing.T) {
+	t.Run("Success - replaces and removes the previous photo", func(t *testing.T) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		blobs := storage.NewLocalStore(t.TempDir())
+		professionalService := NewProfessionalService(mockRepo, testCache(), blobs, nil, nil)
+		require.NoError(t, blobs.Put(context.Background(), "photos/1/antiga.png", bytes.NewReader(pngContent)))
+		mockRepo.On("FindByID", uint(1)).Return(&domain.Professional{ID: 1, PhotoKey: "photos/1/antiga.png"}, nil).Once()
+		mockRepo.On("Update", mock.AnythingOfType("*domain.Professional"), []string{"photo_key", "photo_url"}).Return(nil).Once()
+
+		professional, err := professionalService.UpdatePhoto(1, int64(len(jpegContent)), bytes.NewReader(jpegContent))
+
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(professional.PhotoKey, "photos/1/"))
+		assert.True(t, strings.HasSuffix(professional.PhotoKey, ".jpg"))
+		assert.Equal(t, "/professional/1/photo", professional.PhotoURL)
+		_, err = blobs.Open(context.Background(), "photos/1/antiga.png")
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+		mockRepo.AssertExpectations(t)
+	})
+
+	tests := []struct {
+		name    string
+		size    int64
+		content []byte
+	}{
+		{"pdf is not a photo", int64(len(pdfContent)), pdfContent},
+		{"plain text", 5, []byte("texto")},
+		{"empty file", 0, nil},
+		{"over the size limit", domain.MaxPhotoSize + 1, jpegContent},
+	}
+	for _, tt := range tests {
+		t.Run("Error - "+tt.name, func(t *testing.T) {
+			mockRepo := new(repository.MockProfessionalRepository)
+			professionalService := NewProfessionalService(mockRepo, testCache(), storage.NewLocalStore(t.TempDir()), nil, nil)
+			mockRepo.On("FindByID", uint(1)).Return(&domain.Professional{ID: 1}, nil).Maybe()
+
+			_, err := professionalService.UpdatePhoto(1, tt.size, bytes.NewReader(tt.content))
+
+			assert.Equal(t, domain.ErrInvalidPhoto, err)
+			mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("Error - new photo is removed when the update fails", func(t *testing.T) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		blobs := storage.NewLocalStore(t.TempDir())
+		professionalService := NewProfessionalService(mockRepo, testCache(), blobs, nil, nil)
+		var key string
+		mockRepo.On("FindByID", uint(1)).Return(&domain.Professional{ID: 1}, nil).Once()
+		mockRepo.On("Update", mock.AnythingOfType("*domain.Professional"), mock.Anything).
+			Run(func(args mock.Arguments) { key = args.Get(0).(*domain.Professional).PhotoKey }).
+			Return(assert.AnError).Once()
+
+		_, err := professionalService.UpdatePhoto(1, int64(len(jpegContent)), bytes.NewReader(jpegContent))
+
+		assert.Equal(t, assert.AnError, err)
+		_, err = blobs.Open(context.Background(), key)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestDeleteAccount(t *testing.T) {
+	t.Run("Success - removes the returned blobs", func(t *testing.T) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		blobs := storage.NewLocalStore(t.TempDir())
+		professionalService := NewProfessionalService(mockRepo, testCache(), blobs, nil, nil)
+		keys := []string{"photos/1/foto.jpg", "verification/1/rg.pdf"}
+		for _, key := range keys {
+			require.NoError(t, blobs.Put(context.Background(), key, bytes.NewReader(pdfContent)))
+		}
+		mockRepo.On("FindByID", uint(1)).Return(&domain.Professional{ID: 1, Password: hashPassword("senha123")}, nil).Once()
+		mockRepo.On("DeleteAccount", uint(1), mock.AnythingOfType("time.Time")).Return(keys, nil).Once()
+
+		err := professionalService.DeleteAccount(1, "senha123")
+
+		assert.NoError(t, err)
+		for _, key := range keys {
+			_, err := blobs.Open(context.Background(), key)
+			assert.ErrorIs(t, err, storage.ErrNotFound, key)
+		}
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error - active bookings keep the account and its files", func(t *testing.T) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		blobs := storage.NewLocalStore(t.TempDir())
+		professionalService := NewProfessionalService(mockRepo, testCache(), blobs, nil, nil)
+		require.NoError(t, blobs.Put(context.Background(), "photos/1/foto.jpg", bytes.NewReader(jpegContent)))
+		mockRepo.On("FindByID", uint(1)).Return(&domain.Professional{ID: 1, Password: hashPassword("senha123"), PhotoKey: "photos/1/foto.jpg"}, nil).Once()
+		mockRepo.On("DeleteAccount", uint(1), mock.AnythingOfType("time.Time")).Return(nil, domain.ErrActiveBookings).Once()
+
+		err := professionalService.DeleteAccount(1, "senha123")
+
+		assert.Equal(t, domain.ErrActiveBookings, err)
+		file, err := blobs.Open(context.Background(), "photos/1/foto.jpg")
+		require.NoError(t, err)
+		file.Close()
+	})
+
+	t.Run("Error - wrong password", func(t *testing.T) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		professionalService := NewProfessionalService(mockRepo, testCache(), nil, nil, nil)
+		mockRepo.On("FindByID", uint(1)).Return(&domain.Professional{ID: 1, Password: hashPassword("senha123")}, nil).Once()
+
+		err := professionalService.DeleteAccount(1, "errada")
+
+		assert.Equal(t, auth.ErrWrongPassword, err)
+		mockRepo.AssertNotCalled(t, "DeleteAccount", mock.Anything, mock.Anything)
+	})
+}
+
+type failingMailer struct {
+	attempts chan email.Message
+}
+
+func (m *failingMailer) Send(ctx context.Context, msg email.Message) error {
+	m.attempts <- msg
+	return errors.New("servidor SMTP indisponível")
+}
+
+func TestForgotPassword(t *testing.T) {
+	t.Run("Success - token is emailed in the background", func(t *testing.T) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		mailer := email.NewMemoryMailer()
+		professionalService := NewProfessionalService(mockRepo, testCache(), nil, nil, mailer)
+		professional := &domain.Professional{ID: 1, Email: "pro@email.com", Locale: "en"}
+		mockRepo.On("FindByEmail", "pro@email.com").Return(professional, nil).Once()
+		mockRepo.On("Update", professional, []string{"reset_token", "reset_token_expiry"}).Return(nil).Once()
+
+		err := professionalService.ForgotPassword(" pro@email.com ")
+
+		require.NoError(t, err)
+		require.Eventually(t, func() bool { return len(mailer.Messages()) == 1 }, time.Second, 5*time.Millisecond)
+		sent := mailer.Messages()[0]
+		assert.Equal(t, "pro@email.com", sent.To)
+		token := strings.TrimSpace(strings.SplitN(strings.SplitN(sent.Text, "Token: ", 2)[1], "\n", 2)[0])
+		assert.Equal(t, professional.ResetToken, auth.HashToken(token))
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Success - unknown email gets the same response", func(t *testing.T) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		mailer := email.NewMemoryMailer()
+		professionalService := NewProfessionalService(mockRepo, testCache(), nil, nil, mailer)
+		mockRepo.On("FindByEmail", "naoexiste@email.com").Return(nil, gorm.ErrRecordNotFound).Once()
+
+		err := professionalService.ForgotPassword("naoexiste@email.com")
+
+		assert.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success - send failure is not returned", func(t *testing.T) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		mailer := &failingMailer{attempts: make(chan email.Message, 1)}
+		professionalService := NewProfessionalService(mockRepo, testCache(), nil, nil, mailer)
+		mockRepo.On("FindByEmail", "pro@email.com").Return(&domain.Professional{ID: 1, Email: "pro@email.com"}, nil).Once()
+		mockRepo.On("Update", mock.AnythingOfType("*domain.Professional"), mock.Anything).Return(nil).Once()
+
+		err := professionalService.ForgotPassword("pro@email.com")
+
+		assert.NoError(t, err)
+		select {
+		case <-mailer.attempts:
+		case <-time.After(time.Second):
+			t.Fatal("o e-mail de redefinição não foi enviado")
+		}
+	})
+}
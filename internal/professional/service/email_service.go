@@ -0,0 +1,18 @@
+package service
+
+import (
+	"1mao/internal/professional/domain"
+	"1mao/pkg/email"
+	"context"
+	"net/url"
+)
+
+// sendEmail envia o template para o e-mail do profissional, no idioma dele
+func (s *professionalService) sendEmail(ctx context.Context, professional *domain.Professional, template string, data any) error {
+	return email.SendTemplate(ctx, s.mailer, professional.Email, professional.Locale, template, data)
+}
+
+func (s *professionalService) sendVerificationEmail(ctx context.Context, professional *domain.Professional, token string) error {
+	link := email.PublicURL("/verify-email?token=" + url.QueryEscape(token))
+	return s.sendEmail(ctx, professional, email.TemplateEmailVerification, email.EmailVerificationData{Link: link, Professional: true})
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"1mao/internal/professional/domain"
+	"1mao/pkg/auth"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// sendVerification assina o link de verificação para o e-mail atual do
+// profissional e o envia
+func (s *professionalService) sendVerification(ctx context.Context, professional *domain.Professional) error {
+	token, err := auth.IssueEmailVerificationToken(professional.ID, auth.RoleProfessional, professional.Email)
+	if err != nil {
+		return err
+	}
+	return s.sendVerificationEmail(ctx, professional, token)
+}
+
+// 🔹 Confirma o e-mail a partir do link; repetir a confirmação não tem efeito
+func (s *professionalService) VerifyEmail(professionalID uint, email string) error {
+	professional, err := s.findProfessional(professionalID)
+	if err != nil {
+		if errors.Is(err, domain.ErrProfessionalNotFound) {
+			return auth.ErrInvalidVerificationToken
+		}
+		return err
+	}
+	// O link é de um e-mail que não é mais o da conta
+	if professional.Email != email {
+		return auth.ErrInvalidVerificationToken
+	}
+	if professional.EmailVerifiedAt != nil {
+		return nil
+	}
+
+	now := time.Now()
+	professional.EmailVerifiedAt = &now
+	if err := s.repo.Update(professional, "email_verified_at"); err != nil {
+		return err
+	}
+	s.invalidateProfessional(professionalID)
+	return nil
+}
+
+// 🔹 Reenvia o link de verificação, no máximo uma vez por
+// auth.VerificationResendInterval
+func (s *professionalService) ResendVerification(ctx context.Context, professionalID uint) error {
+	professional, err := s.findProfessional(professionalID)
+	if err != nil {
+		return err
+	}
+	if professional.EmailVerifiedAt != nil {
+		return domain.ErrEmailAlreadyVerified
+	}
+	now := time.Now()
+	if now.Sub(professional.VerificationSentAt) < auth.VerificationResendInterval {
+		return auth.ErrResendTooSoon
+	}
+
+	professional.VerificationSentAt = now
+	if err := s.repo.Update(professional, "verification_sent_at"); err != nil {
+		return err
+	}
+	if err := s.sendVerification(ctx, professional); err != nil {
+		return fmt.Errorf("erro ao enviar email: %w", err)
+	}
+	return nil
+}
@@ -1,12 +1,18 @@
 package service
 
 import (
+	notification "1mao/internal/notification/domain"
 	"1mao/internal/professional/domain"
 	"1mao/internal/professional/repository"
 	"1mao/pkg/auth"
+	"1mao/pkg/email"
+	"1mao/pkg/storage"
+	"1mao/pkg/timezone"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"log"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -19,10 +25,42 @@ var (
 
 // 🔹 Interface do serviço de profissionais
 type ProfessionalService interface {
-	Register(professional *domain.Professional) error
+	Register(ctx context.Context, professional *domain.Professional) error
 	GetProfessionalByID(id uint) (*domain.Professional, error)
 	GetAllProfessionals() ([]domain.Professional, error)
+	SearchProfessionals(query *domain.SearchQuery, cursor string, limit int) (*SearchPage, error)
 	Login(email, password string) (string, error) // 🔹 Adicionando Login
+	UpdateServiceArea(professionalID uint, req *ServiceAreaRequest) (*domain.Professional, error)
+	UpdateProfile(professionalID uint, req *ProfileUpdateRequest) (*domain.Professional, error)
+	UpdatePhoto(professionalID uint, size int64, content io.Reader) (*domain.Professional, error)
+	OpenPhoto(professionalID uint) (string, io.ReadCloser, error)
+	ChangePassword(professionalID uint, req *ChangePasswordRequest) error
+	Deactivate(professionalID uint) error
+	Reactivate(professionalID uint) error
+	DeleteAccount(professionalID uint, password string) error
+	ForgotPassword(email string) error
+	ResetPassword(token, newPassword string) error
+	VerifyEmail(professionalID uint, email string) error
+	ResendVerification(ctx context.Context, professionalID uint) error
+
+	CreateService(professionalID uint, offering *domain.ServiceOffering) error
+	ListServices(professionalID uint, onlyActive bool) ([]domain.ServiceOffering, error)
+	UpdateService(professionalID, id uint, update *domain.ServiceOffering) (*domain.ServiceOffering, error)
+	DeleteService(professionalID, id uint) error
+
+	UploadVerificationDocument(professionalID uint, kind domain.DocumentKind, fileName string, size int64, content io.Reader) (*domain.VerificationDocument, error)
+	DeleteVerificationDocument(professionalID, id uint) error
+	SubmitVerification(professionalID uint) (*domain.VerificationRequest, error)
+	GetVerificationStatus(professionalID uint) (*VerificationStatusResponse, error)
+	ListVerifications(status domain.VerificationStatus) ([]domain.VerificationRequest, error)
+	GetVerification(id uint) (*domain.VerificationRequest, error)
+	OpenVerificationDocument(id uint) (*domain.VerificationDocument, io.ReadCloser, error)
+	ReviewVerification(adminID, id uint, approve bool, reason string) (*domain.VerificationRequest, error)
+}
+
+// Notifier entrega notificações em tempo real aos profissionais
+type Notifier interface {
+	SendNotification(notification notification.Notification)
 }
 
 // 🔹 Implementação do serviço de profissionais
@@ -31,6 +69,9 @@ type professionalService struct {
 	authSvc  auth.AuthService
 	cache    *redis.Client
 	cacheTTL time.Duration
+	blobs    storage.BlobStore
+	notifier Notifier
+	mailer   email.Mailer
 }
 
 // 🔹 Adapter para conectar ProfessionalRepository ao AuthService
@@ -51,15 +92,20 @@ func (a *professionalAuthAdapter) FindByEmail(email string) (*auth.Professional,
 	}, nil
 }
 
-// 🔹 Criando o ProfessionalService corretamente
-func NewProfessionalService(repo repository.ProfessionalRepository, redisClient *redis.Client) ProfessionalService {
+// 🔹 Criando o ProfessionalService corretamente. blobs guarda os documentos de
+// verificação; notifier pode ser nil, e então nenhuma notificação é enviada;
+// mailer envia os e-mails de verificação e de redefinição de senha.
+func NewProfessionalService(repo repository.ProfessionalRepository, redisClient *redis.Client, blobs storage.BlobStore, notifier Notifier, mailer email.Mailer) ProfessionalService {
 	authRepo := &professionalAuthAdapter{repo: repo}
 	authSvc := auth.NewAuthService(nil, authRepo) // 🔹 Passamos nil para UserRepository
 
 	return &professionalService{repo: repo,
 		authSvc:  authSvc,
 		cache:    redisClient,
-		cacheTTL: 30 * time.Minute}
+		cacheTTL: 30 * time.Minute,
+		blobs:    blobs,
+		notifier: notifier,
+		mailer:   mailer}
 }
 
 // Helper para operações de cache
@@ -80,6 +126,35 @@ func (s *professionalService) setCache(key string, value interface{}) error {
 	return s.cache.Set(ctx, key, serialized, s.cacheTTL).Err()
 }
 
+// notify avisa o profissional; id identifica o recurso do tipo kind
+func (s *professionalService) notify(kind string, id, professionalID uint, content string) {
+	if s.notifier == nil {
+		return
+	}
+	s.notifier.SendNotification(notification.Notification{
+		Type:         kind,
+		ID:           int(id),
+		ReceiverID:   int(professionalID),
+		ReceiverType: "professional",
+		Content:      content,
+	})
+}
+
+// ProfileCache descarta o perfil e a listagem de profissionais em cache. Serve
+// aos módulos que alteram dados exibidos no perfil, como a nota das avaliações.
+type ProfileCache struct {
+	cache *redis.Client
+}
+
+func NewProfileCache(redisClient *redis.Client) *ProfileCache {
+	return &ProfileCache{cache: redisClient}
+}
+
+// InvalidateProfessional remove as chaves professional:{id} e professional:all
+func (c *ProfileCache) InvalidateProfessional(id uint) {
+	c.cache.Del(ctx, fmt.Sprintf("professional:%d", id), "professional:all")
+}
+
 func (s *professionalService) invalidateCache(pattern string) {
 	keys, err := s.cache.Keys(ctx, pattern).Result()
 	if err == nil {
@@ -88,17 +163,44 @@ func (s *professionalService) invalidateCache(pattern string) {
 }
 
 // 🔹 Registro de profissional
-func (s *professionalService) Register(professional *domain.Professional) error {
+func (s *professionalService) Register(ctx context.Context, professional *domain.Professional) error {
+	zone, err := timezone.Normalize(professional.TimeZone)
+	if err != nil {
+		return err
+	}
+	professional.TimeZone = zone
+	professional.Locale = email.NormalizeLocale(professional.Locale)
+	if err := professional.ValidateLocation(); err != nil {
+		return err
+	}
+
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(professional.Password), bcrypt.DefaultCost)
 	if err != nil {
 		return err
 	}
 	professional.Password = string(hashedPassword)
+	// Estes campos só mudam pelos fluxos próprios (verificação de documentos,
+	// avaliações, desativação), nunca pelo cadastro
+	professional.ID = 0
+	professional.Verified = false
+	professional.Rating = 0
+	professional.ReviewCount = 0
+	professional.Active = true
+	professional.PhotoURL = ""
+	professional.PhotoKey = ""
+	professional.EmailVerifiedAt = nil
+	professional.VerificationSentAt = time.Now()
 	err = s.repo.Create(professional)
-	if err == nil {
-		s.invalidateCache("professionals:*")
+	if err != nil {
+		return err
+	}
+	s.invalidateCache("professionals:*")
+
+	// A conta já existe; sem o e-mail o profissional pede o reenvio
+	if err := s.sendVerification(ctx, professional); err != nil {
+		log.Printf("⚠️ Erro ao enviar verificação de e-mail para o profissional %d: %v", professional.ID, err)
 	}
-	return err
+	return nil
 }
 
 // 🔹 Buscar profissional por ID
@@ -148,3 +250,62 @@ func (s *professionalService) GetAllProfessionals() ([]domain.Professional, erro
 func (s *professionalService) Login(email, password string) (string, error) {
 	return s.authSvc.Login(email, password)
 }
+
+// 🔹 Catálogo de serviços do profissional
+func (s *professionalService) CreateService(professionalID uint, offering *domain.ServiceOffering) error {
+	offering.ID = 0
+	offering.ProfessionalID = professionalID
+	if offering.Currency == "" {
+		offering.Currency = domain.DefaultCurrency
+	}
+	if err := offering.Validate(); err != nil {
+		return err
+	}
+	return s.repo.CreateService(offering)
+}
+
+func (s *professionalService) ListServices(professionalID uint, onlyActive bool) ([]domain.ServiceOffering, error) {
+	return s.repo.ListServices(professionalID, onlyActive)
+}
+
+func (s *professionalService) UpdateService(professionalID, id uint, update *domain.ServiceOffering) (*domain.ServiceOffering, error) {
+	offering, err := s.findOwnedService(professionalID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	offering.Name = update.Name
+	offering.Description = update.Description
+	offering.DurationMinutes = update.DurationMinutes
+	offering.PriceCents = update.PriceCents
+	offering.Active = update.Active
+	if update.Currency != "" {
+		offering.Currency = update.Currency
+	}
+	if err := offering.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateService(offering); err != nil {
+		return nil, err
+	}
+	return offering, nil
+}
+
+func (s *professionalService) DeleteService(professionalID, id uint) error {
+	if _, err := s.findOwnedService(professionalID, id); err != nil {
+		return err
+	}
+	return s.repo.DeleteService(id)
+}
+
+func (s *professionalService) findOwnedService(professionalID, id uint) (*domain.ServiceOffering, error) {
+	offering, err := s.repo.FindServiceByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if offering.ProfessionalID != professionalID {
+		return nil, domain.ErrServiceOfferingNotFound
+	}
+	return offering, nil
+}
@@ -0,0 +1,51 @@
+package service
+
+import "1mao/internal/professional/domain"
+
+// SearchPage é uma página da busca de profissionais
+//
+//	@Description	Página de resultados da busca de profissionais
+//	@name			SearchPage
+//	@model			SearchPage
+type SearchPage struct {
+	Results []domain.SearchResult `json:"results"`
+	// Vazio quando não há mais páginas
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// 🔹 Busca de profissionais por filtros, ordenada por relevância.
+// Não passa pelo cache: cada combinação de filtros geraria uma chave.
+func (s *professionalService) SearchProfessionals(query *domain.SearchQuery, cursor string, limit int) (*SearchPage, error) {
+	if err := query.Normalize(); err != nil {
+		return nil, err
+	}
+	if limit == 0 {
+		limit = domain.DefaultSearchPageSize
+	}
+	if limit < 0 || limit > domain.MaxSearchPageSize {
+		return nil, domain.ErrInvalidSearchQuery
+	}
+
+	var after *domain.SearchCursor
+	if cursor != "" {
+		var err error
+		if after, err = domain.DecodeSearchCursor(cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	results, err := s.repo.Search(query, after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &SearchPage{Results: results}
+	if len(results) > limit {
+		page.Results = results[:limit]
+		page.NextCursor = domain.CursorAfterResult(&page.Results[limit-1]).Encode()
+	}
+	if page.Results == nil {
+		page.Results = []domain.SearchResult{}
+	}
+	return page, nil
+}
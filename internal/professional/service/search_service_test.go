@@ -0,0 +1,88 @@
+package service
+
+import (
+	"testing"
+
+	"1mao/internal/professional/domain"
+	"1mao/internal/professional/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func searchResults(scores ...float64) []domain.SearchResult {
+	results := make([]domain.SearchResult, len(scores))
+	for i, score := range scores {
+		results[i] = domain.SearchResult{Professional: domain.Professional{ID: uint(i + 1)}, Score: score}
+	}
+	return results
+}
+
+func TestSearchProfessionals(t *testing.T) {
+	t.Run("Success - default page size and no next cursor", func(t *testing.T) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		professionalService := NewProfessionalService(mockRepo, testCache(), nil, nil, nil)
+		mockRepo.On("Search", mock.AnythingOfType("*domain.SearchQuery"), (*domain.SearchCursor)(nil), domain.DefaultSearchPageSize+1).
+			Return(searchResults(0.9, 0.5), nil).Once()
+
+		page, err := professionalService.SearchProfessionals(&domain.SearchQuery{}, "", 0)
+
+		require.NoError(t, err)
+		assert.Len(t, page.Results, 2)
+		assert.Empty(t, page.NextCursor)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Success - extra row becomes the next cursor", func(t *testing.T) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		professionalService := NewProfessionalService(mockRepo, testCache(), nil, nil, nil)
+		mockRepo.On("Search", mock.AnythingOfType("*domain.SearchQuery"), (*domain.SearchCursor)(nil), 3).
+			Return(searchResults(0.9, 0.8, 0.7), nil).Once()
+
+		page, err := professionalService.SearchProfessionals(&domain.SearchQuery{}, "", 2)
+
+		require.NoError(t, err)
+		assert.Len(t, page.Results, 2)
+		cursor, err := domain.DecodeSearchCursor(page.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, &domain.SearchCursor{Score: 0.8, ID: 2}, cursor)
+	})
+
+	t.Run("Success - cursor is passed to the repository", func(t *testing.T) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		professionalService := NewProfessionalService(mockRepo, testCache(), nil, nil, nil)
+		after := &domain.SearchCursor{Score: 0.8, ID: 2}
+		mockRepo.On("Search", mock.AnythingOfType("*domain.SearchQuery"), after, 3).Return(nil, nil).Once()
+
+		page, err := professionalService.SearchProfessionals(&domain.SearchQuery{}, after.Encode(), 2)
+
+		require.NoError(t, err)
+		assert.NotNil(t, page.Results)
+		assert.Empty(t, page.Results)
+		mockRepo.AssertExpectations(t)
+	})
+
+	invalid := []struct {
+		name   string
+		query  domain.SearchQuery
+		cursor string
+		limit  int
+	}{
+		{"limit over max", domain.SearchQuery{}, "", domain.MaxSearchPageSize + 1},
+		{"negative limit", domain.SearchQuery{}, "", -1},
+		{"tampered cursor", domain.SearchQuery{}, "não-é-um-cursor", 10},
+		{"invalid filters", domain.SearchQuery{RadiusKm: 5}, "", 10},
+	}
+	for _, tt := range invalid {
+		t.Run("Error - "+tt.name, func(t *testing.T) {
+			mockRepo := new(repository.MockProfessionalRepository)
+			professionalService := NewProfessionalService(mockRepo, testCache(), nil, nil, nil)
+
+			_, err := professionalService.SearchProfessionals(&tt.query, tt.cursor, tt.limit)
+
+			assert.Equal(t, domain.ErrInvalidSearchQuery, err)
+			mockRepo.AssertNotCalled(t, "Search", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"1mao/internal/professional/domain"
+	"1mao/pkg/geo"
+)
+
+// ServiceAreaRequest define o endereço e a região atendida pelo profissional
+//
+//	@Description	Endereço do profissional e região em que ele atende
+//	@name			ServiceAreaRequest
+//	@model			ServiceAreaRequest
+type ServiceAreaRequest struct {
+	Address geo.Address `json:"address"`
+	// Raio em km a partir do endereço (exige coordenadas); zero não limita
+	ServiceRadiusKm float64 `json:"service_radius_km" example:"15"`
+	// CEPs ou prefixos de CEP atendidos
+	ServicePostalCodes []string `json:"service_postal_codes" example:"01310,01311000"`
+}
+
+// serviceAreaColumns são as colunas gravadas por UpdateServiceArea
+var serviceAreaColumns = []string{
+	"address_street", "address_number", "address_complement", "address_district",
+	"address_city", "address_state", "address_postal_code", "address_latitude",
+	"address_longitude", "service_radius_km", "service_postal_codes",
+}
+
+// 🔹 Atualiza endereço e área de atendimento do profissional
+func (s *professionalService) UpdateServiceArea(professionalID uint, req *ServiceAreaRequest) (*domain.Professional, error) {
+	professional, err := s.findProfessional(professionalID)
+	if err != nil {
+		return nil, err
+	}
+
+	professional.Address = req.Address
+	professional.ServiceRadiusKm = req.ServiceRadiusKm
+	professional.ServicePostalCodes = req.ServicePostalCodes
+	if err := professional.ValidateLocation(); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(professional, serviceAreaColumns...); err != nil {
+		return nil, err
+	}
+	s.invalidateProfessional(professionalID)
+	return professional, nil
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"1mao/internal/professional/domain"
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VerificationStatusResponse resume a verificação do profissional
+//
+//	@Description	Situação da verificação do profissional
+//	@name			VerificationStatusResponse
+//	@model			VerificationStatusResponse
+type VerificationStatusResponse struct {
+	Verified bool `json:"verified"`
+	// Solicitação mais recente, se houver
+	Latest *domain.VerificationRequest `json:"latest,omitempty"`
+	// Documentos enviados que ainda não fazem parte de uma solicitação
+	Documents []domain.VerificationDocument `json:"documents"`
+}
+
+// 🔹 Guarda um documento de verificação; o formato é detectado pelo conteúdo
+func (s *professionalService) UploadVerificationDocument(professionalID uint, kind domain.DocumentKind, fileName string, size int64, content io.Reader) (*domain.VerificationDocument, error) {
+	if !kind.Valid() || size <= 0 || size > domain.MaxDocumentSize {
+		return nil, domain.ErrInvalidDocument
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(content, head)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return nil, domain.ErrInvalidDocument
+	}
+	head = head[:n]
+	contentType := http.DetectContentType(head)
+	ext, ok := domain.AllowedDocumentTypes[contentType]
+	if !ok {
+		return nil, domain.ErrInvalidDocument
+	}
+
+	document := &domain.VerificationDocument{
+		ProfessionalID: professionalID,
+		Kind:           kind,
+		FileName:       filepath.Base(strings.TrimSpace(fileName)),
+		ContentType:    contentType,
+		Size:           size,
+		StorageKey:     fmt.Sprintf("verification/%d/%s%s", professionalID, uuid.NewString(), ext),
+	}
+	// O arquivo gravado nunca passa do tamanho declarado
+	body := io.LimitReader(io.MultiReader(bytes.NewReader(head), content), size)
+	if err := s.blobs.Put(ctx, document.StorageKey, body); err != nil {
+		return nil, err
+	}
+	if err := s.repo.CreateDocument(document); err != nil {
+		s.removeBlobs(document.StorageKey)
+		return nil, err
+	}
+	return document, nil
+}
+
+// 🔹 Remove um documento que ainda não foi enviado para análise
+func (s *professionalService) DeleteVerificationDocument(professionalID, id uint) error {
+	document, err := s.repo.FindDocument(id)
+	if err != nil {
+		return err
+	}
+	if document.ProfessionalID != professionalID || document.RequestID != nil {
+		return domain.ErrDocumentNotFound
+	}
+	if err := s.repo.DeleteDocument(id); err != nil {
+		return err
+	}
+	s.removeBlobs(document.StorageKey)
+	return nil
+}
+
+// 🔹 Envia os documentos pendentes para análise dos administradores
+func (s *professionalService) SubmitVerification(professionalID uint) (*domain.VerificationRequest, error) {
+	return s.repo.SubmitVerification(professionalID)
+}
+
+func (s *professionalService) GetVerificationStatus(professionalID uint) (*VerificationStatusResponse, error) {
+	professional, err := s.GetProfessionalByID(professionalID)
+	if err != nil {
+		return nil, domain.ErrProfessionalNotFound
+	}
+
+	response := &VerificationStatusResponse{Verified: professional.Verified}
+	latest, err := s.repo.LatestVerification(professionalID)
+	switch err {
+	case nil:
+		response.Latest = latest
+	case domain.ErrVerificationNotFound:
+	default:
+		return nil, err
+	}
+
+	if response.Documents, err = s.repo.ListUnsubmittedDocuments(professionalID); err != nil {
+		return nil, err
+	}
+	if response.Documents == nil {
+		response.Documents = []domain.VerificationDocument{}
+	}
+	return response, nil
+}
+
+// 🔹 Operações dos administradores
+func (s *professionalService) ListVerifications(status domain.VerificationStatus) ([]domain.VerificationRequest, error) {
+	if status == "" {
+		status = domain.VerificationPending
+	}
+	requests, err := s.repo.ListVerifications(status)
+	if err != nil {
+		return nil, err
+	}
+	if requests == nil {
+		requests = []domain.VerificationRequest{}
+	}
+	return requests, nil
+}
+
+func (s *professionalService) GetVerification(id uint) (*domain.VerificationRequest, error) {
+	return s.repo.FindVerification(id)
+}
+
+// OpenVerificationDocument abre o arquivo do documento; quem chama fecha o leitor
+func (s *professionalService) OpenVerificationDocument(id uint) (*domain.VerificationDocument, io.ReadCloser, error) {
+	document, err := s.repo.FindDocument(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	file, err := s.blobs.Open(ctx, document.StorageKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return document, file, nil
+}
+
+// ReviewVerification aprova ou recusa a solicitação e avisa o profissional
+func (s *professionalService) ReviewVerification(adminID, id uint, approve bool, reason string) (*domain.VerificationRequest, error) {
+	request, err := s.repo.ReviewVerification(id, adminID, approve, reason, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	kind, content := "verification_rejected", "Sua verificação foi recusada: "+request.Reason
+	if approve {
+		kind, content = "verification_approved", "Sua verificação foi aprovada"
+		s.invalidateProfessional(request.ProfessionalID)
+	}
+	s.notify(kind, request.ID, request.ProfessionalID, content)
+	return request, nil
+}
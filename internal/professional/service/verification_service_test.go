@@ -0,0 +1,171 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	notification "1mao/internal/notification/domain"
+	"1mao/internal/professional/domain"
+	"1mao/internal/professional/repository"
+	"1mao/pkg/storage"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockNotifier struct {
+	mock.Mock
+}
+
+func (m *MockNotifier) SendNotification(n notification.Notification) {
+	m.Called(n)
+}
+
+// testCache aponta para um Redis inexistente: as falhas de cache são
+// ignoradas pelo serviço e não há retentativas
+func testCache() *redis.Client {
+	return redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1})
+}
+
+// Cabeçalhos mínimos reconhecidos por http.DetectContentType
+var (
+	pdfContent = []byte("%PDF-1.4\n% documento de teste\n")
+	pngContent = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+)
+
+func TestUploadVerificationDocument(t *testing.T) {
+	t.Run("Success - content type comes from the bytes", func(t *testing.T) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		blobs := storage.NewLocalStore(t.TempDir())
+		professionalService := NewProfessionalService(mockRepo, testCache(), blobs, nil, nil)
+		mockRepo.On("CreateDocument", mock.AnythingOfType("*domain.VerificationDocument")).Return(nil).Once()
+
+		// Nome e extensão enganosos não importam
+		document, err := professionalService.UploadVerificationDocument(1, domain.DocumentID, "../rg.exe", int64(len(pdfContent)), bytes.NewReader(pdfContent))
+
+		require.NoError(t, err)
+		assert.Equal(t, "application/pdf", document.ContentType)
+		assert.Equal(t, "rg.exe", document.FileName)
+		assert.True(t, strings.HasPrefix(document.StorageKey, "verification/1/"))
+		assert.True(t, strings.HasSuffix(document.StorageKey, ".pdf"))
+
+		stored, err := blobs.Open(context.Background(), document.StorageKey)
+		require.NoError(t, err)
+		defer stored.Close()
+		content, _ := io.ReadAll(stored)
+		assert.Equal(t, pdfContent, content)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Success - stored file is cut at the declared size", func(t *testing.T) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		blobs := storage.NewLocalStore(t.TempDir())
+		professionalService := NewProfessionalService(mockRepo, testCache(), blobs, nil, nil)
+		mockRepo.On("CreateDocument", mock.AnythingOfType("*domain.VerificationDocument")).Return(nil).Once()
+
+		body := append(append([]byte{}, pngContent...), bytes.Repeat([]byte{0}, 100)...)
+		document, err := professionalService.UploadVerificationDocument(1, domain.DocumentCertification, "cert.png", int64(len(pngContent)), bytes.NewReader(body))
+
+		require.NoError(t, err)
+		assert.Equal(t, "image/png", document.ContentType)
+		stored, err := blobs.Open(context.Background(), document.StorageKey)
+		require.NoError(t, err)
+		defer stored.Close()
+		content, _ := io.ReadAll(stored)
+		assert.Len(t, content, len(pngContent))
+	})
+
+	tests := []struct {
+		name    string
+		kind    domain.DocumentKind
+		size    int64
+		content []byte
+	}{
+		{"unsupported type", domain.DocumentID, 11, []byte("texto comum")},
+		{"html disguised as pdf", domain.DocumentID, 28, []byte("<html><body>oi</body></html>")},
+		{"unknown kind", domain.DocumentKind("selfie"), int64(len(pdfContent)), pdfContent},
+		{"empty file", domain.DocumentID, 0, nil},
+		{"over the size limit", domain.DocumentID, domain.MaxDocumentSize + 1, pdfContent},
+	}
+	for _, tt := range tests {
+		t.Run("Error - "+tt.name, func(t *testing.T) {
+			mockRepo := new(repository.MockProfessionalRepository)
+			professionalService := NewProfessionalService(mockRepo, testCache(), storage.NewLocalStore(t.TempDir()), nil, nil)
+
+			_, err := professionalService.UploadVerificationDocument(1, tt.kind, "doc", tt.size, bytes.NewReader(tt.content))
+
+			assert.Equal(t, domain.ErrInvalidDocument, err)
+			mockRepo.AssertNotCalled(t, "CreateDocument", mock.Anything)
+		})
+	}
+
+	t.Run("Error - blob is removed when the record fails", func(t *testing.T) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		blobs := storage.NewLocalStore(t.TempDir())
+		professionalService := NewProfessionalService(mockRepo, testCache(), blobs, nil, nil)
+		var key string
+		mockRepo.On("CreateDocument", mock.AnythingOfType("*domain.VerificationDocument")).
+			Run(func(args mock.Arguments) { key = args.Get(0).(*domain.VerificationDocument).StorageKey }).
+			Return(assert.AnError).Once()
+
+		_, err := professionalService.UploadVerificationDocument(1, domain.DocumentID, "rg.pdf", int64(len(pdfContent)), bytes.NewReader(pdfContent))
+
+		assert.Equal(t, assert.AnError, err)
+		_, err = blobs.Open(context.Background(), key)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestReviewVerification(t *testing.T) {
+	t.Run("Success - approval notifies the professional", func(t *testing.T) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		mockNotifier := new(MockNotifier)
+		professionalService := NewProfessionalService(mockRepo, testCache(), nil, mockNotifier, nil)
+		mockRepo.On("ReviewVerification", uint(5), uint(9), true, "", mock.AnythingOfType("time.Time")).
+			Return(&domain.VerificationRequest{ID: 5, ProfessionalID: 1, Status: domain.VerificationApproved}, nil).Once()
+		mockNotifier.On("SendNotification", mock.MatchedBy(func(n notification.Notification) bool {
+			return n.Type == "verification_approved" && n.ReceiverID == 1 && n.ID == 5
+		})).Once()
+
+		request, err := professionalService.ReviewVerification(9, 5, true, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.VerificationApproved, request.Status)
+		mockRepo.AssertExpectations(t)
+		mockNotifier.AssertExpectations(t)
+	})
+
+	t.Run("Success - rejection sends the reason", func(t *testing.T) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		mockNotifier := new(MockNotifier)
+		professionalService := NewProfessionalService(mockRepo, testCache(), nil, mockNotifier, nil)
+		mockRepo.On("ReviewVerification", uint(5), uint(9), false, "Documento ilegível", mock.AnythingOfType("time.Time")).
+			Return(&domain.VerificationRequest{ID: 5, ProfessionalID: 1, Status: domain.VerificationRejected, Reason: "Documento ilegível"}, nil).Once()
+		mockNotifier.On("SendNotification", mock.MatchedBy(func(n notification.Notification) bool {
+			return n.Type == "verification_rejected" && strings.Contains(n.Content, "Documento ilegível")
+		})).Once()
+
+		_, err := professionalService.ReviewVerification(9, 5, false, "Documento ilegível")
+
+		assert.NoError(t, err)
+		mockNotifier.AssertExpectations(t)
+	})
+
+	t.Run("Error - already reviewed request is not notified", func(t *testing.T) {
+		mockRepo := new(repository.MockProfessionalRepository)
+		mockNotifier := new(MockNotifier)
+		professionalService := NewProfessionalService(mockRepo, testCache(), nil, mockNotifier, nil)
+		mockRepo.On("ReviewVerification", uint(5), uint(9), true, "", mock.AnythingOfType("time.Time")).
+			Return(nil, domain.ErrVerificationNotPending).Once()
+
+		_, err := professionalService.ReviewVerification(9, 5, true, "")
+
+		assert.Equal(t, domain.ErrVerificationNotPending, err)
+		mockNotifier.AssertNotCalled(t, "SendNotification", mock.Anything)
+	})
+}
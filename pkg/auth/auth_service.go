@@ -24,6 +24,12 @@ type Professional struct {
 	Password string
 }
 
+// Papéis gravados no claim "role" do JWT
+const (
+	RoleUser         = "user"
+	RoleProfessional = "professional"
+)
+
 type Claims struct {
     UserID uint   `json:"user_id"`
     Role   string `json:"role"`
@@ -69,7 +75,7 @@ func (s *authService) Login(email, password string) (string, error) {
 			log.Println("🟢 Usuário encontrado:", user.Email)
 			userID = user.ID
 			hashedPassword = user.Password
-			role = "user"
+			role = RoleUser
 		} else {
 			log.Println("⚠️ Usuário não encontrado no repositório de usuários.")
 		}
@@ -82,7 +88,7 @@ func (s *authService) Login(email, password string) (string, error) {
 			log.Println("🟢 Profissional encontrado:", professional.Email)
 			userID = professional.ID
 			hashedPassword = professional.Password
-			role = "professional"
+			role = RoleProfessional
 		} else {
 			log.Println("⚠️ Profissional não encontrado no repositório de profissionais.")
 		}
@@ -104,6 +110,17 @@ func (s *authService) Login(email, password string) (string, error) {
 	log.Println("✅ Senha correta! Gerando token JWT...")
 
 	// 🔐 Criar o token JWT
+	tokenString, err := IssueToken(userID, role)
+	if err != nil {
+		return "", err
+	}
+
+	log.Println("✅ Token JWT gerado com sucesso para:", email)
+	return tokenString, nil
+}
+
+// IssueToken gera o JWT de 24h com o ID e o papel do usuário autenticado
+func IssueToken(userID uint, role string) (string, error) {
 	secretKey := os.Getenv("JWT_SECRET")
 	if secretKey == "" {
 		log.Println("⚠️ Chave secreta JWT não está configurada!")
@@ -121,8 +138,6 @@ func (s *authService) Login(email, password string) (string, error) {
 		log.Println("❌ Erro ao gerar token JWT:", err)
 		return "", errors.New("erro ao gerar token de autenticação")
 	}
-
-	log.Println("✅ Token JWT gerado com sucesso para:", email)
 	return tokenString, nil
 }
 
@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Regras de senha, telefone e links de conta comuns a clientes e profissionais
+const (
+	// MinPasswordLength é o tamanho mínimo de uma nova senha
+	MinPasswordLength = 8
+	// ResetTokenTTL é a validade do token de redefinição de senha
+	ResetTokenTTL = time.Hour
+	// VerificationResendInterval é o intervalo mínimo entre dois envios do
+	// link de verificação de e-mail
+	VerificationResendInterval = time.Minute
+)
+
+var (
+	ErrInvalidPassword = errors.New("password must have at least 8 characters")
+	ErrWrongPassword   = errors.New("current password does not match")
+	// Token de uso único (NewOneTimeToken) inexistente ou vencido
+	ErrInvalidToken  = errors.New("invalid or expired token")
+	ErrResendTooSoon = errors.New("verification email sent too recently")
+)
+
+// ValidatePassword verifica a nova senha antes de gerar o hash
+func ValidatePassword(password string) error {
+	if utf8.RuneCountInString(password) < MinPasswordLength {
+		return ErrInvalidPassword
+	}
+	return nil
+}
+
+// ValidPhone aceita de 8 a 15 dígitos, com "+" opcional no início
+func ValidPhone(phone string) bool {
+	digits := strings.TrimPrefix(phone, "+")
+	if len(digits) < 8 || len(digits) > 15 {
+		return false
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
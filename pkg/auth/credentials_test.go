@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePassword(t *testing.T) {
+	assert.NoError(t, ValidatePassword("senha123"))
+	assert.Equal(t, ErrInvalidPassword, ValidatePassword("curta"))
+	// O limite conta caracteres, não bytes
+	assert.Equal(t, ErrInvalidPassword, ValidatePassword("ççççççç"))
+}
+
+func TestValidPhone(t *testing.T) {
+	tests := []struct {
+		phone string
+		valid bool
+	}{
+		{"+5511999999999", true},
+		{"11999999999", true},
+		{"12345678", true},
+		{"1234567", false},
+		{"+1234567890123456", false},
+		{"(11) 99999-9999", false},
+		{"+", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.phone, func(t *testing.T) {
+			assert.Equal(t, tt.valid, ValidPhone(tt.phone))
+		})
+	}
+}
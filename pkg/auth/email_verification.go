@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"errors"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// EmailVerificationTTL é a validade do link de verificação de e-mail
+const EmailVerificationTTL = 48 * time.Hour
+
+const emailVerificationPurpose = "email_verification"
+
+var ErrInvalidVerificationToken = errors.New("invalid or expired verification token")
+
+// EmailVerification identifica a conta e o e-mail confirmados pelo link
+type EmailVerification struct {
+	UserID uint
+	// Papel da conta, igual ao claim "role" do login ("user" ou "professional")
+	Role  string
+	Email string
+}
+
+// IssueEmailVerificationToken assina o token do link de verificação. O e-mail
+// vai junto para que um link antigo não confirme um endereço trocado depois.
+func IssueEmailVerificationToken(userID uint, role, email string) (string, error) {
+	key, err := emailVerificationKey()
+	if err != nil {
+		return "", err
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":     strconv.FormatUint(uint64(userID), 10),
+		"role":    role,
+		"email":   email,
+		"purpose": emailVerificationPurpose,
+		"exp":     time.Now().Add(EmailVerificationTTL).Unix(),
+	})
+	return token.SignedString(key)
+}
+
+// ParseEmailVerificationToken valida assinatura, validade e finalidade do token
+func ParseEmailVerificationToken(tokenString string) (*EmailVerification, error) {
+	key, err := emailVerificationKey()
+	if err != nil {
+		return nil, err
+	}
+	token, err := jwt.Parse(tokenString, func(*jwt.Token) (interface{}, error) {
+		return key, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil || !token.Valid {
+		return nil, ErrInvalidVerificationToken
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || claims["purpose"] != emailVerificationPurpose {
+		return nil, ErrInvalidVerificationToken
+	}
+	sub, _ := claims["sub"].(string)
+	id, err := strconv.ParseUint(sub, 10, 32)
+	if err != nil {
+		return nil, ErrInvalidVerificationToken
+	}
+	role, _ := claims["role"].(string)
+	email, _ := claims["email"].(string)
+	if role == "" || email == "" {
+		return nil, ErrInvalidVerificationToken
+	}
+	return &EmailVerification{UserID: uint(id), Role: role, Email: email}, nil
+}
+
+// emailVerificationKey deriva do JWT_SECRET uma chave própria, para que o
+// token do link não seja aceito como token de sessão
+func emailVerificationKey() ([]byte, error) {
+	secret := os.Getenv("JWT_SECRET")
+	if secret == "" {
+		return nil, errors.New("erro interno na autenticação")
+	}
+	sum := sha256.Sum256([]byte(emailVerificationPurpose + ":" + secret))
+	return sum[:], nil
+}
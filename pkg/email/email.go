@@ -0,0 +1,63 @@
+// Package email envia os e-mails transacionais. O Mailer abstrai o envio:
+// SMTPMailer entrega por um servidor SMTP, FileMailer grava arquivos .eml
+// (desenvolvimento) e MemoryMailer guarda as mensagens para os testes. As
+// mensagens saem dos templates embutidos, em texto e HTML, no idioma do
+// destinatário.
+package email
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidRecipient = errors.New("invalid email recipient")
+	ErrInvalidSender    = errors.New("invalid email sender")
+	ErrUnknownTemplate  = errors.New("unknown email template")
+)
+
+// SendTimeout limita cada envio feito em segundo plano por SendAsync
+const SendTimeout = time.Minute
+
+// Mailer entrega uma mensagem já montada
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// SendAsync executa send em segundo plano, para que a resposta não espere o
+// servidor de e-mail. O contexto de send mantém os valores de parent, mas
+// não é cancelado com ele (a requisição termina antes do envio) e vence em
+// SendTimeout. Falhas só são registradas, identificadas por what.
+func SendAsync(parent context.Context, what string, send func(ctx context.Context) error) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(parent), SendTimeout)
+		defer cancel()
+		if err := send(ctx); err != nil {
+			log.Printf("⚠️ Erro ao enviar %s: %v", what, err)
+		}
+	}()
+}
+
+// PublicURL monta um link da API para os e-mails a partir de APP_URL
+// (padrão http://localhost:8080)
+func PublicURL(path string) string {
+	base := strings.TrimRight(os.Getenv("APP_URL"), "/")
+	if base == "" {
+		base = "http://localhost:8080"
+	}
+	return base + path
+}
+
+// SendTemplate renderiza o template name no idioma locale e o envia para to
+func SendTemplate(ctx context.Context, mailer Mailer, to, locale, name string, data any) error {
+	msg, err := Render(locale, name, data)
+	if err != nil {
+		return err
+	}
+	msg.To = to
+	return mailer.Send(ctx, msg)
+}
@@ -0,0 +1,50 @@
+package email
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+)
+
+// FileMailer grava cada mensagem como um arquivo .eml em dir, no lugar de
+// enviá-la. Serve para desenvolvimento: os arquivos abrem em qualquer
+// cliente de e-mail.
+type FileMailer struct {
+	dir  string
+	from string
+}
+
+func NewFileMailer(dir, from string) *FileMailer {
+	return &FileMailer{dir: dir, from: from}
+}
+
+func (m *FileMailer) Send(ctx context.Context, msg Message) error {
+	to, err := msg.recipient()
+	if err != nil {
+		return err
+	}
+	from, err := parseSender(m.from)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(m.dir, 0o750); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	file, err := os.CreateTemp(m.dir, now.Format("20060102-150405")+"-*.eml")
+	if err != nil {
+		return err
+	}
+	if err := msg.writeTo(file, from, now); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	log.Printf("✉️ E-mail %q para %s gravado em %s", msg.Subject, to, file.Name())
+	return nil
+}
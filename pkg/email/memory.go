@@ -0,0 +1,34 @@
+package email
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryMailer guarda as mensagens enviadas em memória, para os testes
+// conferirem destinatário e conteúdo
+type MemoryMailer struct {
+	mu   sync.Mutex
+	sent []Message
+}
+
+func NewMemoryMailer() *MemoryMailer {
+	return &MemoryMailer{}
+}
+
+func (m *MemoryMailer) Send(ctx context.Context, msg Message) error {
+	if _, err := msg.recipient(); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+// Messages devolve uma cópia das mensagens enviadas, na ordem de envio
+func (m *MemoryMailer) Messages() []Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Message(nil), m.sent...)
+}
@@ -0,0 +1,96 @@
+package email
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"time"
+)
+
+// Message é um e-mail pronto para envio. HTML é opcional; sem ele a
+// mensagem sai só em texto.
+type Message struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// recipient valida o destinatário, que precisa ser um endereço simples
+func (m *Message) recipient() (string, error) {
+	addr, err := mail.ParseAddress(m.To)
+	if err != nil || addr.Address != m.To {
+		return "", ErrInvalidRecipient
+	}
+	return addr.Address, nil
+}
+
+// parseSender valida o remetente, que pode ter nome ("1Mão <no-reply@1mao.com>")
+func parseSender(from string) (*mail.Address, error) {
+	addr, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, ErrInvalidSender
+	}
+	return addr, nil
+}
+
+// writeTo escreve a mensagem no formato RFC 5322, com as partes de texto e
+// HTML em multipart/alternative
+func (m *Message) writeTo(w io.Writer, from *mail.Address, now time.Time) error {
+	buf := bufio.NewWriter(w)
+	header := func(key, value string) {
+		fmt.Fprintf(buf, "%s: %s\r\n", key, value)
+	}
+	header("From", from.String())
+	header("To", m.To)
+	header("Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	header("Date", now.Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+
+	if m.HTML == "" {
+		header("Content-Type", `text/plain; charset="utf-8"`)
+		header("Content-Transfer-Encoding", "quoted-printable")
+		buf.WriteString("\r\n")
+		if err := writeQuotedPrintable(buf, m.Text); err != nil {
+			return err
+		}
+		return buf.Flush()
+	}
+
+	parts := multipart.NewWriter(buf)
+	header("Content-Type", `multipart/alternative; boundary="`+parts.Boundary()+`"`)
+	buf.WriteString("\r\n")
+	// Clientes de e-mail preferem a última parte que sabem exibir
+	for _, part := range []struct{ contentType, body string }{
+		{"text/plain", m.Text},
+		{"text/html", m.HTML},
+	} {
+		w, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType + `; charset="utf-8"`},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return err
+		}
+		if err := writeQuotedPrintable(w, part.body); err != nil {
+			return err
+		}
+	}
+	if err := parts.Close(); err != nil {
+		return err
+	}
+	return buf.Flush()
+}
+
+func writeQuotedPrintable(w io.Writer, body string) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := io.WriteString(qp, body); err != nil {
+		return err
+	}
+	return qp.Close()
+}
@@ -0,0 +1,148 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSender = &mail.Address{Name: "1Mão", Address: "no-reply@1mao.com"}
+
+func writeMessage(t *testing.T, msg Message) *mail.Message {
+	t.Helper()
+	var out bytes.Buffer
+	require.NoError(t, msg.writeTo(&out, testSender, time.Date(2030, 1, 2, 15, 4, 5, 0, time.UTC)))
+	parsed, err := mail.ReadMessage(&out)
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestMessage_WriteTo_Multipart(t *testing.T) {
+	parsed := writeMessage(t, Message{
+		To:      "ana@email.com",
+		Subject: "🔑 Redefinição de Senha",
+		Text:    "Olá, Ana!\n",
+		HTML:    "<p>Olá, <b>Ana</b>!</p>",
+	})
+
+	// O assunto vai Q-encoded no cabeçalho e volta igual ao decodificar
+	rawSubject := parsed.Header.Get("Subject")
+	assert.True(t, strings.HasPrefix(rawSubject, "=?utf-8?q?"), rawSubject)
+	subject, err := new(mime.WordDecoder).DecodeHeader(rawSubject)
+	require.NoError(t, err)
+	assert.Equal(t, "🔑 Redefinição de Senha", subject)
+
+	from, err := parsed.Header.AddressList("From")
+	require.NoError(t, err)
+	assert.Equal(t, testSender.Address, from[0].Address)
+	assert.Equal(t, "ana@email.com", parsed.Header.Get("To"))
+	assert.Equal(t, "1.0", parsed.Header.Get("MIME-Version"))
+	assert.Equal(t, "Wed, 02 Jan 2030 15:04:05 +0000", parsed.Header.Get("Date"))
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	parts := multipart.NewReader(parsed.Body, params["boundary"])
+	var types, bodies []string
+	for {
+		part, err := parts.NextRawPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		assert.Equal(t, "quoted-printable", part.Header.Get("Content-Transfer-Encoding"))
+		body, err := io.ReadAll(quotedprintable.NewReader(part))
+		require.NoError(t, err)
+		types = append(types, part.Header.Get("Content-Type"))
+		bodies = append(bodies, string(body))
+	}
+	// Texto antes do HTML: os clientes exibem a última parte que entendem
+	assert.Equal(t, []string{`text/plain; charset="utf-8"`, `text/html; charset="utf-8"`}, types)
+	// O quoted-printable transforma as quebras de linha em CRLF
+	assert.Equal(t, []string{"Olá, Ana!\r\n", "<p>Olá, <b>Ana</b>!</p>"}, bodies)
+}
+
+func TestMessage_WriteTo_TextOnly(t *testing.T) {
+	text := "Linha com acentuação e uma linha bem comprida " + strings.Repeat("x", 100) + "\n"
+	parsed := writeMessage(t, Message{To: "ana@email.com", Subject: "Plain subject", Text: text})
+
+	assert.Equal(t, "Plain subject", parsed.Header.Get("Subject"))
+	assert.Equal(t, `text/plain; charset="utf-8"`, parsed.Header.Get("Content-Type"))
+	assert.Equal(t, "quoted-printable", parsed.Header.Get("Content-Transfer-Encoding"))
+
+	raw, err := io.ReadAll(parsed.Body)
+	require.NoError(t, err)
+	for _, line := range strings.Split(string(raw), "\r\n") {
+		assert.LessOrEqual(t, len(line), 76, "linhas quoted-printable têm no máximo 76 caracteres")
+	}
+	body, err := io.ReadAll(quotedprintable.NewReader(bytes.NewReader(raw)))
+	require.NoError(t, err)
+	assert.Equal(t, text, strings.ReplaceAll(string(body), "\r\n", "\n"))
+}
+
+func TestMessage_Recipient(t *testing.T) {
+	tests := []struct {
+		to string
+		ok bool
+	}{
+		{"ana@email.com", true},
+		{"Ana <ana@email.com>", false},
+		{"ana@email.com\r\nBcc: outro@email.com", false},
+		{"", false},
+		{"sem-arroba", false},
+	}
+	for _, tt := range tests {
+		msg := Message{To: tt.to}
+		_, err := msg.recipient()
+		if tt.ok {
+			assert.NoError(t, err, tt.to)
+		} else {
+			assert.Equal(t, ErrInvalidRecipient, err, tt.to)
+		}
+	}
+}
+
+func TestMemoryMailer(t *testing.T) {
+	mailer := NewMemoryMailer()
+
+	assert.Equal(t, ErrInvalidRecipient, mailer.Send(context.Background(), Message{To: "inválido"}))
+	require.NoError(t, mailer.Send(context.Background(), Message{To: "ana@email.com", Subject: "Oi"}))
+
+	sent := mailer.Messages()
+	require.Len(t, sent, 1)
+	assert.Equal(t, "Oi", sent[0].Subject)
+	// A cópia devolvida não altera o que foi guardado
+	sent[0].Subject = "alterado"
+	assert.Equal(t, "Oi", mailer.Messages()[0].Subject)
+}
+
+func TestFileMailer(t *testing.T) {
+	dir := t.TempDir()
+
+	assert.Equal(t, ErrInvalidSender, NewFileMailer(dir, "").Send(context.Background(), Message{To: "ana@email.com"}))
+
+	mailer := NewFileMailer(dir, "1Mão <no-reply@1mao.com>")
+	require.NoError(t, mailer.Send(context.Background(), Message{To: "ana@email.com", Subject: "Oi", Text: "Olá\n"}))
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	raw, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	parsed, err := mail.ReadMessage(bytes.NewReader(raw))
+	require.NoError(t, err)
+	assert.Equal(t, "ana@email.com", parsed.Header.Get("To"))
+}
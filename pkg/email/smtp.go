@@ -0,0 +1,128 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+// TLSMode define como a conexão com o servidor SMTP é protegida
+type TLSMode string
+
+const (
+	// TLSStartTLS conecta em texto e negocia TLS com STARTTLS (porta 587)
+	TLSStartTLS TLSMode = "starttls"
+	// TLSImplicit conecta direto por TLS (porta 465)
+	TLSImplicit TLSMode = "tls"
+	// TLSNone não usa TLS; só para servidores locais de teste (MailHog etc.)
+	TLSNone TLSMode = "none"
+)
+
+// defaultSMTPTimeout limita conexão e envio quando o contexto não tem prazo
+const defaultSMTPTimeout = 30 * time.Second
+
+// SMTPConfig configura o SMTPMailer. Sem Username o envio é feito sem
+// autenticação.
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	TLS      TLSMode
+	Username string
+	Password string
+	// Remetente das mensagens, com nome opcional
+	From string
+}
+
+// SMTPMailer entrega as mensagens por um servidor SMTP, abrindo uma conexão
+// por envio
+type SMTPMailer struct {
+	config SMTPConfig
+}
+
+func NewSMTPMailer(config SMTPConfig) *SMTPMailer {
+	return &SMTPMailer{config: config}
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, msg Message) error {
+	to, err := msg.recipient()
+	if err != nil {
+		return err
+	}
+	from, err := parseSender(m.config.From)
+	if err != nil {
+		return err
+	}
+	var body bytes.Buffer
+	if err := msg.writeTo(&body, from, time.Now()); err != nil {
+		return err
+	}
+
+	conn, err := m.dial(ctx)
+	if err != nil {
+		return fmt.Errorf("erro ao conectar ao servidor SMTP: %w", err)
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(defaultSMTPTimeout)
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, m.config.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if m.config.TLS == TLSStartTLS {
+		if err := client.StartTLS(&tls.Config{ServerName: m.config.Host}); err != nil {
+			return err
+		}
+	}
+	if m.config.Username != "" {
+		auth := smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)
+		if err := client.Auth(auth); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(from.Address); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(body.Bytes()); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	log.Printf("✅ E-mail %q enviado para %s", msg.Subject, to)
+	return client.Quit()
+}
+
+// dial abre a conexão TCP, já em TLS no modo TLSImplicit
+func (m *SMTPMailer) dial(ctx context.Context) (net.Conn, error) {
+	addr := net.JoinHostPort(m.config.Host, strconv.Itoa(m.config.Port))
+	dialer := &net.Dialer{Timeout: defaultSMTPTimeout}
+	if m.config.TLS == TLSImplicit {
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: &tls.Config{ServerName: m.config.Host}}
+		return tlsDialer.DialContext(ctx, "tcp", addr)
+	}
+	return dialer.DialContext(ctx, "tcp", addr)
+}
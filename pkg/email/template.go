@@ -0,0 +1,184 @@
+package email
+
+import (
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+// DefaultLocale é o idioma usado quando o destinatário não tem um suportado
+const DefaultLocale = "pt-BR"
+
+// Templates disponíveis. Cada um tem, por idioma, um <nome>.txt com o
+// assunto (bloco "subject") e o corpo em texto, e um <nome>.html com o bloco
+// "content" exibido dentro de layout.html.
+const (
+	TemplatePasswordReset     = "password_reset"
+	TemplateEmailChange       = "email_change"
+	TemplateEmailVerification = "email_verification"
+	TemplateBookingConfirmed  = "booking_confirmed"
+	TemplatePaymentReceipt    = "payment_receipt"
+)
+
+// PasswordResetData alimenta TemplatePasswordReset
+type PasswordResetData struct {
+	Token        string
+	Professional bool
+}
+
+// EmailChangeData alimenta TemplateEmailChange
+type EmailChangeData struct {
+	Token string
+}
+
+// EmailVerificationData alimenta TemplateEmailVerification
+type EmailVerificationData struct {
+	Link         string
+	Professional bool
+}
+
+// BookingConfirmedData alimenta TemplateBookingConfirmed. Start e End já
+// vêm no fuso do destinatário.
+type BookingConfirmedData struct {
+	Name             string
+	ProfessionalName string
+	BookingID        uint
+	Start            time.Time
+	End              time.Time
+	Address          string
+	PriceCents       int64
+	Currency         string
+}
+
+// PaymentReceiptData alimenta TemplatePaymentReceipt
+type PaymentReceiptData struct {
+	Name          string
+	TransactionID string
+	BookingID     string
+	AmountCents   int64
+	Currency      string
+	PaidAt        time.Time
+}
+
+// locale reúne as convenções de formatação de um idioma
+type locale struct {
+	dateTime  string
+	decimal   string
+	thousands string
+}
+
+var locales = map[string]locale{
+	"pt-BR": {dateTime: "02/01/2006 às 15:04", decimal: ",", thousands: "."},
+	"en":    {dateTime: "Jan 2, 2006 at 3:04 PM", decimal: ".", thousands: ","},
+}
+
+// languages liga o idioma da tag (a parte antes do "-") ao locale suportado
+var languages = map[string]string{
+	"pt": "pt-BR",
+	"en": "en",
+}
+
+// MatchLocale encontra o idioma suportado para a tag ("en-US" → "en",
+// "pt" → "pt-BR")
+func MatchLocale(tag string) (string, bool) {
+	tag = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+	language, _, _ := strings.Cut(tag, "-")
+	name, ok := languages[language]
+	return name, ok
+}
+
+// NormalizeLocale é MatchLocale com DefaultLocale para tags não suportadas
+func NormalizeLocale(tag string) string {
+	if name, ok := MatchLocale(tag); ok {
+		return name
+	}
+	return DefaultLocale
+}
+
+func (l locale) funcs() map[string]any {
+	return map[string]any{
+		"datetime": func(t time.Time) string { return t.Format(l.dateTime) },
+		"money":    l.money,
+	}
+}
+
+// money formata centavos com os separadores do idioma ("R$ 1.234,56")
+func (l locale) money(cents int64, currency string) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	units := strconv.FormatInt(cents/100, 10)
+	for i := len(units) - 3; i > 0; i -= 3 {
+		units = units[:i] + l.thousands + units[i:]
+	}
+	symbol := strings.ToUpper(currency)
+	if symbol == "" || symbol == "BRL" {
+		symbol = "R$"
+	}
+	return fmt.Sprintf("%s%s %s%s%02d", sign, symbol, units, l.decimal, cents%100)
+}
+
+//go:embed templates
+var templateFS embed.FS
+
+type localizedTemplate struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+// templates guarda os templates já interpretados, por idioma e nome
+var templates = loadTemplates()
+
+func loadTemplates() map[string]map[string]*localizedTemplate {
+	loaded := make(map[string]map[string]*localizedTemplate, len(locales))
+	for name, l := range locales {
+		dir := path.Join("templates", name)
+		files, err := fs.Glob(templateFS, path.Join(dir, "*.txt"))
+		if err != nil {
+			panic(err)
+		}
+
+		loaded[name] = make(map[string]*localizedTemplate, len(files))
+		for _, file := range files {
+			base := strings.TrimSuffix(path.Base(file), ".txt")
+			text := texttemplate.Must(texttemplate.New(path.Base(file)).Funcs(l.funcs()).ParseFS(templateFS, file))
+			html := htmltemplate.Must(htmltemplate.New("layout.html").Funcs(l.funcs()).
+				ParseFS(templateFS, path.Join(dir, "layout.html"), path.Join(dir, base+".html")))
+			loaded[name][base] = &localizedTemplate{text: text, html: html}
+		}
+	}
+	return loaded
+}
+
+// Render monta assunto e corpos do template name no idioma locale (com
+// fallback para DefaultLocale). O destinatário fica a cargo de quem envia.
+func Render(locale, name string, data any) (Message, error) {
+	tmpl, ok := templates[NormalizeLocale(locale)][name]
+	if !ok {
+		return Message{}, ErrUnknownTemplate
+	}
+
+	var subject, text, html strings.Builder
+	if err := tmpl.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return Message{}, err
+	}
+	if err := tmpl.text.Execute(&text, data); err != nil {
+		return Message{}, err
+	}
+	if err := tmpl.html.Execute(&html, data); err != nil {
+		return Message{}, err
+	}
+	return Message{
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    strings.TrimSpace(text.String()) + "\n",
+		HTML:    html.String(),
+	}, nil
+}
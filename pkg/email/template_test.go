@@ -0,0 +1,135 @@
+package email
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchLocale(t *testing.T) {
+	tests := []struct {
+		tag    string
+		locale string
+		ok     bool
+	}{
+		{"pt-BR", "pt-BR", true},
+		{"pt", "pt-BR", true},
+		{"pt_PT", "pt-BR", true},
+		{"en", "en", true},
+		{"en-US", "en", true},
+		{" EN-gb ", "en", true},
+		{"es-AR", "", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			locale, ok := MatchLocale(tt.tag)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.locale, locale)
+		})
+	}
+}
+
+func TestNormalizeLocale(t *testing.T) {
+	assert.Equal(t, "en", NormalizeLocale("en-US"))
+	assert.Equal(t, DefaultLocale, NormalizeLocale("fr"))
+	assert.Equal(t, DefaultLocale, NormalizeLocale(""))
+}
+
+func TestLocale_Money(t *testing.T) {
+	tests := []struct {
+		locale   string
+		cents    int64
+		currency string
+		want     string
+	}{
+		{"pt-BR", 0, "BRL", "R$ 0,00"},
+		{"pt-BR", 5, "BRL", "R$ 0,05"},
+		{"pt-BR", 15000, "", "R$ 150,00"},
+		{"pt-BR", 123456, "brl", "R$ 1.234,56"},
+		{"pt-BR", 123456789, "BRL", "R$ 1.234.567,89"},
+		{"pt-BR", -123456, "BRL", "-R$ 1.234,56"},
+		{"pt-BR", -5, "BRL", "-R$ 0,05"},
+		{"en", 100000, "BRL", "R$ 1,000.00"},
+		{"en", 99999, "usd", "USD 999.99"},
+		{"en", -100000000, "USD", "-USD 1,000,000.00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.locale+" "+tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, locales[tt.locale].money(tt.cents, tt.currency))
+		})
+	}
+}
+
+func TestRender_AllTemplates(t *testing.T) {
+	start := time.Date(2030, 3, 4, 10, 0, 0, 0, time.UTC)
+	templates := []struct {
+		name string
+		data any
+		// Trecho que precisa aparecer no texto em cada idioma
+		text map[string]string
+	}{
+		{TemplatePasswordReset, PasswordResetData{Token: "tok-123", Professional: true},
+			map[string]string{"pt-BR": "tok-123", "en": "tok-123"}},
+		{TemplateEmailChange, EmailChangeData{Token: "tok-456"},
+			map[string]string{"pt-BR": "tok-456", "en": "tok-456"}},
+		{TemplateEmailVerification, EmailVerificationData{Link: "https://1mao.com/verify?token=abc"},
+			map[string]string{"pt-BR": "https://1mao.com/verify?token=abc", "en": "https://1mao.com/verify?token=abc"}},
+		{TemplateBookingConfirmed, BookingConfirmedData{
+			Name: "Ana", ProfessionalName: "João", BookingID: 9, Start: start, End: start.Add(time.Hour),
+			Address: "Rua A, 10", PriceCents: 123456, Currency: "BRL",
+		}, map[string]string{"pt-BR": "R$ 1.234,56", "en": "R$ 1,234.56"}},
+		{TemplatePaymentReceipt, PaymentReceiptData{
+			Name: "Ana", TransactionID: "tx-1", BookingID: "9", AmountCents: 15000, Currency: "BRL", PaidAt: start,
+		}, map[string]string{"pt-BR": "04/03/2030 às 10:00", "en": "Mar 4, 2030 at 10:00 AM"}},
+	}
+
+	for _, tmpl := range templates {
+		for _, locale := range []string{"pt-BR", "en"} {
+			t.Run(locale+"/"+tmpl.name, func(t *testing.T) {
+				msg, err := Render(locale, tmpl.name, tmpl.data)
+
+				require.NoError(t, err)
+				assert.NotEmpty(t, msg.Subject)
+				assert.NotContains(t, msg.Subject, "\n")
+				assert.Contains(t, msg.Text, tmpl.text[locale])
+				assert.True(t, strings.HasSuffix(msg.Text, "\n"))
+				assert.Contains(t, msg.HTML, "<html")
+				assert.NotContains(t, msg.Text+msg.HTML, "<no value>")
+			})
+		}
+	}
+}
+
+func TestRender_Locales(t *testing.T) {
+	pt, err := Render("pt-BR", TemplatePasswordReset, PasswordResetData{Token: "x"})
+	require.NoError(t, err)
+	assert.Equal(t, "🔑 Redefinição de Senha", pt.Subject)
+
+	en, err := Render("en-US", TemplateEmailVerification, EmailVerificationData{Link: "x"})
+	require.NoError(t, err)
+	assert.Equal(t, "✅ Confirm your email", en.Subject)
+
+	// Idioma não suportado cai para pt-BR
+	fallback, err := Render("ja", TemplatePasswordReset, PasswordResetData{Token: "x"})
+	require.NoError(t, err)
+	assert.Equal(t, pt.Subject, fallback.Subject)
+}
+
+func TestRender_EscapesHTML(t *testing.T) {
+	msg, err := Render("en", TemplateBookingConfirmed, BookingConfirmedData{
+		Name: "<script>alert(1)</script>", ProfessionalName: "João", BookingID: 1,
+	})
+
+	require.NoError(t, err)
+	assert.NotContains(t, msg.HTML, "<script>")
+	assert.Contains(t, msg.HTML, "&lt;script&gt;")
+}
+
+func TestRender_UnknownTemplate(t *testing.T) {
+	_, err := Render("en", "missing", nil)
+	assert.Equal(t, ErrUnknownTemplate, err)
+}
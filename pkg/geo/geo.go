@@ -0,0 +1,174 @@
+// Package geo reúne endereço, coordenadas e área de atendimento
+package geo
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// EarthRadiusKm é o raio médio usado na fórmula de haversine
+const EarthRadiusKm = 6371.0
+
+// MaxServiceRadiusKm limita o raio de atendimento de um profissional
+const MaxServiceRadiusKm = 500
+
+var (
+	ErrInvalidAddress     = errors.New("invalid address")
+	ErrInvalidServiceArea = errors.New("invalid service area")
+)
+
+// Address é um endereço postal com coordenadas opcionais
+//
+//	@Description	Endereço com CEP e coordenadas opcionais
+//	@name			Address
+//	@model			Address
+type Address struct {
+	Street     string   `json:"street" example:"Av. Paulista"`
+	Number     string   `json:"number" example:"1000"`
+	Complement string   `json:"complement,omitempty" example:"apto 12"`
+	District   string   `json:"district" example:"Bela Vista"`
+	City       string   `json:"city" example:"São Paulo"`
+	State      string   `json:"state" example:"SP"`
+	PostalCode string   `json:"postal_code" example:"01310100"`
+	Latitude   *float64 `json:"latitude,omitempty" example:"-23.5614"`
+	Longitude  *float64 `json:"longitude,omitempty" example:"-46.6559"`
+}
+
+// IsZero indica se nenhum campo do endereço foi preenchido
+func (a *Address) IsZero() bool {
+	return *a == Address{}
+}
+
+// HasCoordinates indica se o endereço tem latitude e longitude
+func (a *Address) HasCoordinates() bool {
+	return a.Latitude != nil && a.Longitude != nil
+}
+
+// Line devolve o endereço numa linha ("Av. Paulista, 1000 - apto 12, Bela
+// Vista, São Paulo/SP, 01310100"), para e-mails; vazio se não houver endereço
+func (a *Address) Line() string {
+	if a.IsZero() {
+		return ""
+	}
+	street := strings.Join(nonEmpty(a.Street, a.Number), ", ")
+	if a.Complement != "" {
+		street += " - " + a.Complement
+	}
+	city := strings.Join(nonEmpty(a.City, a.State), "/")
+	return strings.Join(nonEmpty(street, a.District, city, a.PostalCode), ", ")
+}
+
+func nonEmpty(values ...string) []string {
+	kept := values[:0]
+	for _, v := range values {
+		if v != "" {
+			kept = append(kept, v)
+		}
+	}
+	return kept
+}
+
+// Normalize apara os campos, deixa só os dígitos do CEP e valida o endereço:
+// rua, cidade, estado e CEP são obrigatórios e as coordenadas vêm juntas
+func (a *Address) Normalize() error {
+	a.Street = strings.TrimSpace(a.Street)
+	a.Number = strings.TrimSpace(a.Number)
+	a.Complement = strings.TrimSpace(a.Complement)
+	a.District = strings.TrimSpace(a.District)
+	a.City = strings.TrimSpace(a.City)
+	a.State = strings.ToUpper(strings.TrimSpace(a.State))
+	a.PostalCode = NormalizePostalCode(a.PostalCode)
+
+	if a.Street == "" || a.City == "" || a.State == "" || a.PostalCode == "" {
+		return ErrInvalidAddress
+	}
+	if (a.Latitude == nil) != (a.Longitude == nil) {
+		return ErrInvalidAddress
+	}
+	if a.HasCoordinates() && !ValidCoordinates(*a.Latitude, *a.Longitude) {
+		return ErrInvalidAddress
+	}
+	return nil
+}
+
+// NormalizePostalCode mantém apenas os dígitos do CEP ("01310-100" vira "01310100")
+func NormalizePostalCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, code)
+}
+
+// ValidCoordinates verifica se latitude e longitude estão dentro do globo
+func ValidCoordinates(lat, lng float64) bool {
+	if math.IsNaN(lat) || math.IsNaN(lng) {
+		return false
+	}
+	return lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180
+}
+
+// DistanceKm calcula a distância em km entre dois pontos pela fórmula de haversine
+func DistanceKm(lat1, lng1, lat2, lng2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat := toRad(lat2 - lat1)
+	dLng := toRad(lng2 - lng1)
+	h := math.Pow(math.Sin(dLat/2), 2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Pow(math.Sin(dLng/2), 2)
+	return 2 * EarthRadiusKm * math.Asin(math.Sqrt(math.Min(1, h)))
+}
+
+// ServiceArea é a região atendida por um profissional: um raio a partir do
+// endereço dele, uma lista de CEPs, ou os dois. Sem nenhum dos dois o
+// profissional atende em qualquer lugar.
+type ServiceArea struct {
+	// Centro do raio (coordenadas do endereço do profissional)
+	Latitude  *float64
+	Longitude *float64
+	RadiusKm  float64
+	// CEPs atendidos; uma entrada mais curta que 8 dígitos vale como prefixo
+	// (ex.: "01310" cobre 01310-000 a 01310-999)
+	PostalCodes []string
+}
+
+// Restricted indica se o profissional limita a região atendida
+func (s *ServiceArea) Restricted() bool {
+	return s.RadiusKm > 0 || len(s.PostalCodes) > 0
+}
+
+// Validate exige centro para o raio, raio dentro do limite e CEPs não vazios
+func (s *ServiceArea) Validate() error {
+	if s.RadiusKm < 0 || s.RadiusKm > MaxServiceRadiusKm || math.IsNaN(s.RadiusKm) {
+		return ErrInvalidServiceArea
+	}
+	if s.RadiusKm > 0 && (s.Latitude == nil || s.Longitude == nil) {
+		return ErrInvalidServiceArea
+	}
+	for _, code := range s.PostalCodes {
+		if code == "" || code != NormalizePostalCode(code) {
+			return ErrInvalidServiceArea
+		}
+	}
+	return nil
+}
+
+// Covers indica se o endereço fica dentro da área: pelo CEP ou, quando o
+// endereço tem coordenadas, pela distância até o centro
+func (s *ServiceArea) Covers(a *Address) bool {
+	if !s.Restricted() {
+		return true
+	}
+	postalCode := NormalizePostalCode(a.PostalCode)
+	for _, code := range s.PostalCodes {
+		if postalCode != "" && strings.HasPrefix(postalCode, code) {
+			return true
+		}
+	}
+	if s.RadiusKm > 0 && s.Latitude != nil && s.Longitude != nil && a.HasCoordinates() {
+		return DistanceKm(*s.Latitude, *s.Longitude, *a.Latitude, *a.Longitude) <= s.RadiusKm
+	}
+	return false
+}
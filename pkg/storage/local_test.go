@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStore_PutOpenDelete(t *testing.T) {
+	ctx := context.Background()
+	store := NewLocalStore(t.TempDir())
+
+	require.NoError(t, store.Put(ctx, "verification/1/doc.pdf", strings.NewReader("conteúdo")))
+
+	file, err := store.Open(ctx, "verification/1/doc.pdf")
+	require.NoError(t, err)
+	content, err := io.ReadAll(file)
+	file.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "conteúdo", string(content))
+
+	require.NoError(t, store.Delete(ctx, "verification/1/doc.pdf"))
+	_, err = store.Open(ctx, "verification/1/doc.pdf")
+	assert.ErrorIs(t, err, ErrNotFound)
+	// Remover de novo não é erro
+	assert.NoError(t, store.Delete(ctx, "verification/1/doc.pdf"))
+}
+
+func TestLocalStore_KeysStayInsideRoot(t *testing.T) {
+	ctx := context.Background()
+	parent := t.TempDir()
+	root := filepath.Join(parent, "uploads")
+	store := NewLocalStore(root)
+
+	tests := []struct {
+		name string
+		key  string
+		err  error
+	}{
+		{"parent directory", "../escaped.txt", ErrInvalidKey},
+		{"nested parent directory", "photos/../../escaped.txt", ErrInvalidKey},
+		{"parent inside key", "photos/../escaped.txt", ErrInvalidKey},
+		{"absolute path", "/escaped.txt", nil},
+		{"backslash", `..\escaped.txt`, ErrInvalidKey},
+		{"empty", "", ErrInvalidKey},
+		{"root only", "../", ErrInvalidKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := store.Put(ctx, tt.key, strings.NewReader("x"))
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			_, statErr := os.Stat(filepath.Join(parent, "escaped.txt"))
+			assert.True(t, os.IsNotExist(statErr), "a chave %q escreveu fora de root", tt.key)
+		})
+	}
+
+	// A chave absoluta é relativa a root
+	_, err := os.Stat(filepath.Join(root, "escaped.txt"))
+	assert.NoError(t, err)
+}
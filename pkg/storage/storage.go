@@ -0,0 +1,22 @@
+// Package storage guarda arquivos enviados pelos usuários. O BlobStore
+// abstrai o backend; LocalStore grava no sistema de arquivos.
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var (
+	ErrNotFound   = errors.New("blob not found")
+	ErrInvalidKey = errors.New("invalid blob key")
+)
+
+// BlobStore grava e lê conteúdos identificados por uma chave no formato
+// "pasta/sub/arquivo"
+type BlobStore interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
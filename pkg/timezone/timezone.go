@@ -0,0 +1,39 @@
+// Package timezone centraliza o tratamento de fusos horários IANA
+package timezone
+
+import (
+	"errors"
+	"time"
+	// Embute a base IANA no binário: a imagem alpine de produção não tem tzdata
+	_ "time/tzdata"
+)
+
+// Default é o fuso usado quando o usuário não informou nenhum
+const Default = "America/Sao_Paulo"
+
+var ErrInvalid = errors.New("invalid time zone")
+
+// Load carrega o fuso IANA (ex.: "America/Manaus"); vazio carrega o Default
+func Load(name string) (*time.Location, error) {
+	if name == "" {
+		name = Default
+	}
+	// time.LoadLocation aceita "Local", que depende do servidor
+	if name == "Local" {
+		return nil, ErrInvalid
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+	return loc, nil
+}
+
+// Normalize valida o fuso e devolve o nome a ser gravado
+func Normalize(name string) (string, error) {
+	loc, err := Load(name)
+	if err != nil {
+		return "", err
+	}
+	return loc.String(), nil
+}
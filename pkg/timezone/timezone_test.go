@@ -0,0 +1,41 @@
+package timezone
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad_Default(t *testing.T) {
+	loc, err := Load("")
+
+	require.NoError(t, err)
+	assert.Equal(t, Default, loc.String())
+	// São Paulo não tem horário de verão desde 2019: sempre UTC-3
+	_, offset := time.Date(2030, 1, 15, 12, 0, 0, 0, loc).Zone()
+	assert.Equal(t, -3*60*60, offset)
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{name: "America/Manaus", want: "America/Manaus"},
+		{name: "", want: Default},
+		{name: "Local", wantErr: true},
+		{name: "Marte/Olympus", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := Normalize(tt.name)
+		if tt.wantErr {
+			assert.ErrorIs(t, err, ErrInvalid, tt.name)
+			continue
+		}
+		assert.NoError(t, err, tt.name)
+		assert.Equal(t, tt.want, got)
+	}
+}